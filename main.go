@@ -1,6 +1,6 @@
 // Copyright (c) 2020-2022 Doc.ai and/or its affiliates.
 //
-// Copyright (c) 2023-2024 Cisco and/or its affiliates.
+// Copyright (c) 2023-2026 Cisco and/or its affiliates.
 //
 // SPDX-License-Identifier: Apache-2.0
 //
@@ -27,42 +27,129 @@ import (
 	"net/url"
 	"os"
 	"os/signal"
+	"slices"
+	"strings"
+	"sync"
 	"syscall"
 	"time"
 
 	"github.com/edwarnicke/grpcfd"
 
+	registryapi "github.com/networkservicemesh/api/pkg/api/registry"
+
 	"github.com/networkservicemesh/sdk-k8s/pkg/registry/chains/registryk8s"
-	"github.com/networkservicemesh/sdk-k8s/pkg/tools/k8s"
+	"github.com/networkservicemesh/sdk-k8s/pkg/tools/k8s/client/clientset/versioned"
+	registryserver "github.com/networkservicemesh/sdk/pkg/registry"
 	"github.com/networkservicemesh/sdk/pkg/registry/common/authorize"
+	"github.com/networkservicemesh/sdk/pkg/registry/core/chain"
+	"github.com/networkservicemesh/sdk/pkg/registry/core/streamchannel"
 	"github.com/networkservicemesh/sdk/pkg/tools/opentelemetry"
+	"github.com/networkservicemesh/sdk/pkg/tools/prometheus"
 	"github.com/networkservicemesh/sdk/pkg/tools/spiffejwt"
 	"github.com/networkservicemesh/sdk/pkg/tools/token"
 	"github.com/networkservicemesh/sdk/pkg/tools/tracing"
 
 	nested "github.com/antonfisher/nested-logrus-formatter"
 	"github.com/kelseyhightower/envconfig"
+	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	"github.com/spiffe/go-spiffe/v2/bundle/x509bundle"
+	"github.com/spiffe/go-spiffe/v2/spiffeid"
 	"github.com/spiffe/go-spiffe/v2/spiffetls/tlsconfig"
+	"github.com/spiffe/go-spiffe/v2/svid/x509svid"
 	"github.com/spiffe/go-spiffe/v2/workloadapi"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
+	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/backoff"
 	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/encoding/gzip"
+	"google.golang.org/grpc/keepalive"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
 
 	"github.com/networkservicemesh/sdk/pkg/tools/debug"
-	"github.com/networkservicemesh/sdk/pkg/tools/grpcutils"
 	"github.com/networkservicemesh/sdk/pkg/tools/log"
 	"github.com/networkservicemesh/sdk/pkg/tools/log/logruslogger"
 	"github.com/networkservicemesh/sdk/pkg/tools/pprofutils"
+
+	"github.com/networkservicemesh/cmd-registry-k8s/pkg/registry/common/clampexpiration"
+	"github.com/networkservicemesh/cmd-registry-k8s/pkg/registry/common/filterexpired"
+	"github.com/networkservicemesh/cmd-registry-k8s/pkg/registry/common/holddown"
+	"github.com/networkservicemesh/cmd-registry-k8s/pkg/registry/common/namespacerouting"
+	"github.com/networkservicemesh/cmd-registry-k8s/pkg/registry/common/normalizeurl"
+	"github.com/networkservicemesh/cmd-registry-k8s/pkg/registry/common/ownership"
+	"github.com/networkservicemesh/cmd-registry-k8s/pkg/registry/common/quota"
+	"github.com/networkservicemesh/cmd-registry-k8s/pkg/registry/common/ratelimit"
+	"github.com/networkservicemesh/cmd-registry-k8s/pkg/registry/common/serverexpiration"
+	"github.com/networkservicemesh/cmd-registry-k8s/pkg/registry/common/slowrequest"
+	"github.com/networkservicemesh/cmd-registry-k8s/pkg/registry/common/stats"
+	"github.com/networkservicemesh/cmd-registry-k8s/pkg/registry/common/tenantscope"
+	"github.com/networkservicemesh/cmd-registry-k8s/pkg/registry/common/timing"
+	"github.com/networkservicemesh/cmd-registry-k8s/pkg/registry/common/tracker"
+	"github.com/networkservicemesh/cmd-registry-k8s/pkg/registry/common/validation"
+	"github.com/networkservicemesh/cmd-registry-k8s/pkg/tools/calltimeout"
+	"github.com/networkservicemesh/cmd-registry-k8s/pkg/tools/clientset/applyupdate"
+	"github.com/networkservicemesh/cmd-registry-k8s/pkg/tools/clientset/circuitbreaker"
+	"github.com/networkservicemesh/cmd-registry-k8s/pkg/tools/clientset/clienttiming"
+	"github.com/networkservicemesh/cmd-registry-k8s/pkg/tools/clientset/coalesce"
+	"github.com/networkservicemesh/cmd-registry-k8s/pkg/tools/clientset/conflictretry"
+	"github.com/networkservicemesh/cmd-registry-k8s/pkg/tools/clientset/fieldmanager"
+	"github.com/networkservicemesh/cmd-registry-k8s/pkg/tools/clientset/idempotentdelete"
+	"github.com/networkservicemesh/cmd-registry-k8s/pkg/tools/clientset/informercache"
+	"github.com/networkservicemesh/cmd-registry-k8s/pkg/tools/clientset/managedby"
+	"github.com/networkservicemesh/cmd-registry-k8s/pkg/tools/clientset/multinamespace"
+	"github.com/networkservicemesh/cmd-registry-k8s/pkg/tools/clientset/pagination"
+	"github.com/networkservicemesh/cmd-registry-k8s/pkg/tools/clientset/priority"
+	"github.com/networkservicemesh/cmd-registry-k8s/pkg/tools/clientset/registeredby"
+	"github.com/networkservicemesh/cmd-registry-k8s/pkg/tools/debugserver"
+	"github.com/networkservicemesh/cmd-registry-k8s/pkg/tools/dump"
+	"github.com/networkservicemesh/cmd-registry-k8s/pkg/tools/expiration"
+	"github.com/networkservicemesh/cmd-registry-k8s/pkg/tools/expirecontroller"
+	"github.com/networkservicemesh/cmd-registry-k8s/pkg/tools/expiryqueue"
+	"github.com/networkservicemesh/cmd-registry-k8s/pkg/tools/filesource"
+	"github.com/networkservicemesh/cmd-registry-k8s/pkg/tools/gc"
+	"github.com/networkservicemesh/cmd-registry-k8s/pkg/tools/grpclisten"
+	"github.com/networkservicemesh/cmd-registry-k8s/pkg/tools/handoff"
+	"github.com/networkservicemesh/cmd-registry-k8s/pkg/tools/health"
+	"github.com/networkservicemesh/cmd-registry-k8s/pkg/tools/heartbeat"
+	"github.com/networkservicemesh/cmd-registry-k8s/pkg/tools/ipurl"
+	"github.com/networkservicemesh/cmd-registry-k8s/pkg/tools/jsonlog"
+	"github.com/networkservicemesh/cmd-registry-k8s/pkg/tools/k8serrors"
+	"github.com/networkservicemesh/cmd-registry-k8s/pkg/tools/k8smetrics"
+	"github.com/networkservicemesh/cmd-registry-k8s/pkg/tools/kubeconfig"
+	"github.com/networkservicemesh/cmd-registry-k8s/pkg/tools/leader"
+	"github.com/networkservicemesh/cmd-registry-k8s/pkg/tools/loglevel"
+	"github.com/networkservicemesh/cmd-registry-k8s/pkg/tools/logthrottle"
+	"github.com/networkservicemesh/cmd-registry-k8s/pkg/tools/otelprovider"
+	"github.com/networkservicemesh/cmd-registry-k8s/pkg/tools/otlpexport"
+	"github.com/networkservicemesh/cmd-registry-k8s/pkg/tools/probe"
+	"github.com/networkservicemesh/cmd-registry-k8s/pkg/tools/rbaccheck"
+	"github.com/networkservicemesh/cmd-registry-k8s/pkg/tools/redact"
+	"github.com/networkservicemesh/cmd-registry-k8s/pkg/tools/retrypolicy"
+	"github.com/networkservicemesh/cmd-registry-k8s/pkg/tools/selfsigned"
+	"github.com/networkservicemesh/cmd-registry-k8s/pkg/tools/shard"
+	"github.com/networkservicemesh/cmd-registry-k8s/pkg/tools/statslog"
+	"github.com/networkservicemesh/cmd-registry-k8s/pkg/tools/tracesampling"
 )
 
-// Config is configuration for cmd-registry-memory
+// Config is configuration for cmd-registry-memory.
+// Fields tagged with `secret:"true"` are redacted when the config is logged or served by the debug listener.
 type Config struct {
 	registryk8s.Config
-	ListenOn               []url.URL     `default:"unix:///listen.on.socket" desc:"url to listen on." split_words:"true"`
+	ListenOn               []url.URL     `default:"unix:///listen.on.socket" desc:"url to listen on, unix sockets with ?security=plaintext are served without TLS" split_words:"true"`
 	MaxTokenLifetime       time.Duration `default:"10m" desc:"maximum lifetime of tokens" split_words:"true"`
 	RegistryServerPolicies []string      `default:"etc/nsm/opa/common/.*.rego,etc/nsm/opa/registry/.*.rego,etc/nsm/opa/server/.*.rego" desc:"paths to files and directories that contain registry server policies" split_words:"true"`
 	RegistryClientPolicies []string      `default:"etc/nsm/opa/common/.*.rego,etc/nsm/opa/registry/.*.rego,etc/nsm/opa/client/.*.rego" desc:"paths to files and directories that contain registry client policies" split_words:"true"`
 	LogLevel               string        `default:"INFO" desc:"Log level" split_words:"true"`
+	LogFormat              string        `default:"text" desc:"format of the log output: text or json" split_words:"true"`
+	LogLevelFile           string        `desc:"file with the log level, reloaded when it changes, e.g. mounted from a ConfigMap" split_words:"true"`
 	OpenTelemetryEndpoint  string        `default:"otel-collector.observability.svc.cluster.local:4317" desc:"OpenTelemetry Collector Endpoint" split_words:"true"`
 	MetricsExportInterval  time.Duration `default:"10s" desc:"interval between mertics exports" split_words:"true"`
 	PprofEnabled           bool          `default:"false" desc:"is pprof enabled" split_words:"true"`
@@ -73,24 +160,672 @@ type Config struct {
 	// FWD Refreshes: 1 refresh per sec. 				* 5 fwds
 	// NSC Refreshes: 4 finds (in 1 refresh) per sec. 	* 40 nscs
 	// Total:											= 205
-	KubeletQPS int `default:"205" desc:"kubelet config settings" split_words:"true"`
+	KubeletQPS      int `default:"205" desc:"kubelet config settings" split_words:"true"`
+	KubeletBurst    int `default:"0" desc:"burst of the k8s client rate limiter, 0 means twice the QPS" split_words:"true"`
+	ReadBufferSize  int `default:"32768" desc:"size of the gRPC read buffer for each connection" split_words:"true"`
+	WriteBufferSize int `default:"32768" desc:"size of the gRPC write buffer for each connection" split_words:"true"`
+	// ExpiryQueueEnabled makes the registry delete expired NSEs at their expiration time using a watch-driven
+	// queue. It covers NSEs whose expire timers were lost, e.g. on registry restart.
+	ExpiryQueueEnabled  bool          `default:"false" desc:"delete expired NSEs using a watch-driven expiry queue" split_words:"true"`
+	MinSVIDValidity     time.Duration `default:"1m" desc:"minimum remaining validity of the X.509 SVID required at startup" split_words:"true"`
+	FieldManager        string        `default:"registry-k8s" desc:"field manager used for all NSE and NS writes" split_words:"true"`
+	ForceApply          bool          `default:"false" desc:"force server-side apply patches conflicting with other field managers" split_words:"true"`
+	OtelK8sAttributes   bool          `default:"true" desc:"add k8s pod, node and namespace resource attributes to OpenTelemetry traces and metrics" split_words:"true"`
+	OtelRequired        bool          `default:"false" desc:"fail startup if OpenTelemetry exporters can't reach the collector" split_words:"true"`
+	OtelRequiredTimeout time.Duration `default:"10s" desc:"maximum time to wait for the OpenTelemetry collector to accept an export at startup if OpenTelemetry is required" split_words:"true"`
+	// UseInformerCache makes Find read NSEs and NSs from local caches maintained by watches. Reads become eventually
+	// consistent: an NSE may be returned shortly after it has been deleted and vice versa.
+	UseInformerCache     bool          `default:"false" desc:"serve NSE and NS lists and watches from shared informer caches" split_words:"true"`
+	NseTTLMetric         bool          `default:"false" desc:"record the time left until the expiration of each NSE as registry_k8s_nse_ttl_seconds, requires the informer cache" split_words:"true"`
+	StatsLogInterval     time.Duration `default:"0" desc:"interval between aggregate registry statistics logs, 0 disables them" split_words:"true"`
+	ListenersStopTimeout time.Duration `default:"5s" desc:"maximum time to wait for all listeners to stop on shutdown" split_words:"true"`
+	MapK8sErrorCodes     bool          `default:"true" desc:"return gRPC status codes matching k8s API errors" split_words:"true"`
+	EmitHeartbeatNSE     bool          `default:"false" desc:"periodically refresh a heartbeat NSE to signal the registry liveness" split_words:"true"`
+	HeartbeatNSEName     string        `desc:"name of the heartbeat NSE, defaults to registry-k8s-heartbeat-<hostname>" split_words:"true"`
+	HeartbeatInterval    time.Duration `default:"30s" desc:"interval between heartbeat NSE refreshes" split_words:"true"`
+	// ExpirationParseMode "lenient" treats zero and malformed NSE expiration times as expired, so NSEs zeroed by a CRD
+	// migration are deleted. "strict" skips such NSEs and logs them instead.
+	ExpirationParseMode   string `default:"lenient" desc:"interpretation of zero and malformed NSE expiration times: lenient or strict" split_words:"true"`
+	FilterExpiredFromFind bool   `default:"false" desc:"drop NSEs with past expiration times from Find responses" split_words:"true"`
+	// UpdateMode "apply" writes NSE and NS refreshes as server-side apply patches of their specs without a
+	// ResourceVersion precondition instead of full object updates, avoiding most write conflicts.
+	UpdateMode string `default:"update" desc:"how NSE and NS refreshes are written: update or apply" split_words:"true"`
+	// GCInterval is the period of sweeps deleting NSEs with past expiration times. Unlike the in-memory expire chain,
+	// the sweeps survive restarts of the registry. They list all NSEs, so they are opt-in.
+	GCInterval      time.Duration `default:"0" desc:"interval between sweeps deleting expired NSEs, and NSEs with zero or malformed expiration times in the lenient parse mode, 0 disables them" split_words:"true"`
+	GCWorkers       int           `default:"8" desc:"number of expired NSEs deleted concurrently by a sweep" split_words:"true"`
+	GCDeleteTimeout time.Duration `default:"10s" desc:"timeout of each expired NSE delete including retries" split_words:"true"`
+	ListPageSize    int64         `default:"500" desc:"number of NSEs and NSs listed per page, 0 disables pagination" split_words:"true"`
+	// LeaderElection makes replicas elect a leader with a Lease in LeaderElectionNamespace. Only the leader runs the
+	// expiry queue and GC sweeps, all replicas serve Register and Find.
+	LeaderElection              bool          `default:"false" desc:"run the expiry queue and GC sweeps only in the elected leader replica" split_words:"true"`
+	LeaderElectionLeaseName     string        `default:"registry-k8s-leader" desc:"name of the Lease used for leader election" split_words:"true"`
+	LeaderElectionNamespace     string        `desc:"namespace of the Lease used for leader election, empty uses NSM_NAMESPACE" split_words:"true"`
+	LeaderElectionWarmup        bool          `default:"true" desc:"sweep expired NSEs as soon as a replica becomes the leader" split_words:"true"`
+	LeaderElectionLeaseDuration time.Duration `default:"15s" desc:"time non-leaders wait before taking over a Lease which hasn't been renewed" split_words:"true"`
+	LeaderElectionRenewDeadline time.Duration `default:"10s" desc:"time the leader keeps retrying to renew the Lease before giving up the leadership" split_words:"true"`
+	LeaderElectionRetryPeriod   time.Duration `default:"2s" desc:"time between attempts to acquire or renew the Lease" split_words:"true"`
+	// Sharding splits the expiry queue and GC sweeps between replicas by NSE names instead of running them in a single
+	// leader. Replicas announce themselves with Leases in the registry namespace.
+	Sharding            bool          `default:"false" desc:"split expiration handling between replicas by NSE names" split_words:"true"`
+	ShardGroup          string        `default:"registry-k8s-shard" desc:"name of the shard group, prefix of the membership Leases" split_words:"true"`
+	ShardLeaseDuration  time.Duration `default:"15s" desc:"time a replica stays a shard member without renewing its Lease" split_words:"true"`
+	HealthCheckInterval time.Duration `default:"5s" desc:"interval between health checks of the registry subsystems" split_words:"true"`
+	HealthCheckTimeout  time.Duration `default:"3s" desc:"timeout of API server requests made by health checks" split_words:"true"`
+	ProbeListenOn       string        `desc:"address of the HTTP listener serving /healthz, /readyz and /startupz, empty disables it" split_words:"true"`
+	// LivenessSelfFind makes /healthz Find an NSE through the registry's own NSE chain, so a registry which is alive but
+	// has its request handling deadlocked fails the liveness probe and is restarted.
+	LivenessSelfFind        bool          `default:"false" desc:"fail /healthz if a Find through the registry's own NSE chain doesn't complete in time" split_words:"true"`
+	LivenessSelfFindTimeout time.Duration `default:"5s" desc:"time the Find made by /healthz may take" split_words:"true"`
+	DebugListenOn           string        `desc:"address of the HTTP listener serving pprof, expvar and registry state debug endpoints, empty disables it" split_words:"true"`
+	AdminReadTimeout        time.Duration `default:"5s" desc:"read timeout of the probe and debug HTTP listeners, 0 means no timeout" split_words:"true"`
+	AdminWriteTimeout       time.Duration `default:"10s" desc:"write timeout of the probe and debug HTTP listeners, 0 means no timeout" split_words:"true"`
+	AdminIdleTimeout        time.Duration `default:"60s" desc:"idle timeout of the probe and debug HTTP listeners, 0 means no timeout" split_words:"true"`
+	// DumpOnSignal logs goroutine stacks on SIGUSR1 and the registry state on SIGUSR2. The signals keep switching the log
+	// level to TRACE and back.
+	DumpOnSignal bool `default:"false" desc:"log goroutine stacks on SIGUSR1 and the registry state on SIGUSR2" split_words:"true"`
+	// Prometheus exporter is enabled with PROMETHEUS=true together with TELEMETRY=true
+	PrometheusListenOn            string        `default:":8081" desc:"address of the Prometheus metrics listener" split_words:"true"`
+	PrometheusServerHeaderTimeout time.Duration `default:"5s" desc:"timeout to read headers of Prometheus metrics requests" split_words:"true"`
+	// OwnershipEnforcement records the SPIFFE ID registering an NSE in an annotation and rejects refreshes and
+	// unregisters of the NSE from other SPIFFE IDs, except OwnershipAdminSpiffeIDs.
+	OwnershipEnforcement    bool     `default:"false" desc:"allow only the SPIFFE ID that registered an NSE to refresh and unregister it" split_words:"true"`
+	OwnershipAdminSpiffeIDs []string `desc:"SPIFFE IDs allowed to refresh and unregister NSEs of any owner" split_words:"true"`
+	// AllowedTrustDomains restricts the mTLS peers of the registry server and client to SVIDs of the listed trust
+	// domains. Empty accepts any trust domain of the trust bundle.
+	AllowedTrustDomains []string `desc:"SPIFFE trust domains accepted from mTLS peers, empty accepts any" split_words:"true"`
+	// TLSMode "file" loads the SVID and the CA bundle from PEM files instead of the SPIFFE Workload API, for
+	// environments without a SPIRE agent. The files are reloaded when they change.
+	TLSMode     string `default:"spire" desc:"source of the X.509 SVID and trust bundle: spire, file or selfsigned" split_words:"true"`
+	TLSCertFile string `desc:"PEM file with the certificate chain of the registry, used in the file TLS mode" split_words:"true"`
+	TLSKeyFile  string `desc:"PEM file with the private key of the registry, used in the file TLS mode" split_words:"true" secret:"true"`
+	TLSCAFile   string `desc:"PEM file with the trusted CA certificates, used in the file TLS mode" split_words:"true"`
+	// TLSCipherSuites only apply to TLS 1.2, TLS 1.3 cipher suites are not configurable
+	TLSMinVersion   string   `default:"1.2" desc:"minimum TLS version of the registry server and client: 1.2 or 1.3" split_words:"true"`
+	TLSCipherSuites []string `desc:"allowed TLS 1.2 cipher suites, empty allows the Go defaults" split_words:"true"`
+	// TLSSelfSignedSpiffeID and InsecureListenOn are meant for local development and e2e tests without SPIRE only
+	TLSSelfSignedSpiffeID string        `default:"spiffe://dev.local/registry-k8s" desc:"SPIFFE ID of the SVID generated in the selfsigned TLS mode" split_words:"true"`
+	InsecureListenOn      []url.URL     `desc:"urls to listen on without TLS, for development only" split_words:"true"`
+	WorkloadAPIAddress    string        `desc:"address of the SPIFFE Workload API, empty uses SPIFFE_ENDPOINT_SOCKET" split_words:"true"`
+	SVIDWaitTimeout       time.Duration `default:"1m" desc:"time to wait for the X.509 SVID from the Workload API at startup" split_words:"true"`
+	// SVIDWaitRetry keeps waiting for the Workload API after SVIDWaitTimeout, e.g. to survive the SPIRE agent starting
+	// after the registry, logging an error after each timeout instead of exiting
+	SVIDWaitRetry bool `default:"false" desc:"keep waiting for the X.509 SVID after the timeout instead of exiting" split_words:"true"`
+	// DialTimeout bounds each connection attempt to upstream registries, RequestTimeout bounds their unary calls.
+	// Find streams are not bounded, because watches are long-lived.
+	DialTimeout    time.Duration `default:"5s" desc:"timeout of each connection attempt to upstream registries" split_words:"true"`
+	RequestTimeout time.Duration `default:"15s" desc:"timeout of Register and Unregister calls to upstream registries, 0 disables it" split_words:"true"`
+	// DefaultExpiration and MaxExpiration are applied to registered NSEs before they are written, unlike ExpirePeriod,
+	// which only bounds the in-memory expire timers
+	DefaultExpiration time.Duration `desc:"expiration period of registered NSEs without an expiration time, 0 keeps them without one" split_words:"true"`
+	MaxExpiration     time.Duration `desc:"maximum expiration period of registered NSEs, 0 disables the limit" split_words:"true"`
+	// LogThrottleInterval collapses warnings and errors repeating the same message, e.g. failures of an NSE
+	// unregistration retried by its client, into a single line and a summary per interval
+	LogThrottleInterval time.Duration `default:"1m" desc:"interval in which repeated warnings and errors are logged once, 0 disables throttling" split_words:"true"`
+	// TracingEnabled enables chain traces logging requests and responses of each chain element. TraceSamplingRatio
+	// limits them to a fraction of Register, Unregister and Find calls, unless the caller requests tracing itself.
+	TracingEnabled     bool    `default:"true" desc:"log chain traces of registry requests" split_words:"true"`
+	TraceSamplingRatio float64 `default:"1" desc:"fraction of registry requests with chain traces, from 0 to 1" split_words:"true"`
+	// OtelExporter* configure the OTLP exporters sending spans and metrics to OpenTelemetryEndpoint. Prometheus metrics
+	// are not affected.
+	OtelExporterProtocol string            `default:"grpc" desc:"OTLP protocol of the OpenTelemetry exporters: grpc or http" split_words:"true"`
+	OtelExporterInsecure bool              `default:"true" desc:"connect to the OpenTelemetry collector without TLS" split_words:"true"`
+	OtelExporterCAFile   string            `desc:"PEM file with the CAs verifying the OpenTelemetry collector, empty uses the system CAs" split_words:"true"`
+	OtelExporterCertFile string            `desc:"PEM file with the client certificate for mTLS with the OpenTelemetry collector" split_words:"true"`
+	OtelExporterKeyFile  string            `desc:"PEM file with the client key for mTLS with the OpenTelemetry collector" split_words:"true" secret:"true"`
+	OtelExporterHeaders  map[string]string `desc:"headers sent with OpenTelemetry exports, e.g. api-key:<key>" split_words:"true" secret:"true"`
+	// ChainElementMetrics records the time spent in each chain element and k8s API call, excluding the timed elements
+	// it calls, to find out which of them makes Register or Find slow
+	ChainElementMetrics bool `default:"false" desc:"record latency histograms of registry chain elements and k8s API calls" split_words:"true"`
+	// SlowRequestThreshold logs Register, Unregister and Find calls taking longer with the time spent in each chain
+	// element and k8s API call, e.g. to see client side throttling before clients start timing out
+	SlowRequestThreshold time.Duration `desc:"log registry requests taking longer than the threshold, 0 disables it" split_words:"true"`
+	// Keepalive* make the server ping idle connections, so connections silently dropped by load balancers are closed,
+	// and set how often clients may ping. MaxConnectionAge* make clients reconnect, so Find watches are rebalanced
+	// across replicas.
+	KeepaliveTime                time.Duration `default:"2h" desc:"interval of server pings on idle connections" split_words:"true"`
+	KeepaliveTimeout             time.Duration `default:"20s" desc:"time to wait for a ping ack before closing the connection" split_words:"true"`
+	KeepaliveMinTime             time.Duration `default:"5m" desc:"minimum interval of client pings, clients pinging more often are disconnected" split_words:"true"`
+	KeepalivePermitWithoutStream bool          `default:"false" desc:"allow client pings on connections without streams" split_words:"true"`
+	MaxConnectionAge             time.Duration `desc:"age of connections after which they are gracefully closed, 0 disables it" split_words:"true"`
+	MaxConnectionAgeGrace        time.Duration `desc:"time for requests and Find watches to complete on connections closed for their age, 0 waits forever" split_words:"true"`
+	// MaxRecvMsgSize and MaxSendMsgSize limit the gRPC messages of the server and of the calls to the proxy registry.
+	// MaxConcurrentStreams and MaxConnections make the registry queue requests under load instead of running out of
+	// memory, 0 disables them.
+	MaxRecvMsgSize       int    `default:"4194304" desc:"maximum size of received gRPC messages in bytes" split_words:"true"`
+	MaxSendMsgSize       int    `default:"2147483647" desc:"maximum size of sent gRPC messages in bytes" split_words:"true"`
+	MaxConcurrentStreams uint32 `desc:"maximum number of concurrent requests and Find streams of each connection, 0 disables the limit" split_words:"true"`
+	MaxConnections       int    `desc:"maximum number of simultaneous connections of each listener, 0 disables the limit" split_words:"true"`
+	// UpstreamCompression compresses the calls to the proxy registry, e.g. over WAN links. The server accepts gzip
+	// compressed requests regardless of it and compresses responses like the request.
+	UpstreamCompression string `desc:"compression of calls to the proxy registry: gzip, empty disables it" split_words:"true"`
+	// Socket* apply to the unix sockets of ListenOn and InsecureListenOn, e.g. shared with hostPath volumes
+	SocketFileMode os.FileMode `default:"0777" desc:"file mode of unix sockets, e.g. 0660" split_words:"true"`
+	SocketUID      int         `default:"-1" desc:"owner uid of unix sockets, -1 keeps the uid of the registry" split_words:"true"`
+	SocketGID      int         `default:"-1" desc:"owner gid of unix sockets, -1 keeps the gid of the registry" split_words:"true"`
+	SocketCleanup  string      `default:"always" desc:"removal of existing unix socket files on startup: always, stale or never" split_words:"true"`
+	// HandoffEnabled makes the replica annotate the NSEs registered on it as released on exit, so another replica
+	// deletes them at their expiration time instead of the lost expire timers of the replica
+	HandoffEnabled bool          `default:"false" desc:"hand the expiration of NSEs over to other replicas on exit" split_words:"true"`
+	HandoffTimeout time.Duration `default:"5s" desc:"maximum time to release the NSEs on exit" split_words:"true"`
+	// AdoptionEnabled annotates NSEs with the replica writing them and makes replicas adopt NSEs of replicas which have
+	// crashed without releasing them. Replicas announce themselves with the Leases of ShardGroup.
+	AdoptionEnabled        bool          `default:"false" desc:"adopt the expiration of NSEs of crashed replicas" split_words:"true"`
+	AdoptionResyncInterval time.Duration `default:"30s" desc:"interval between lists of NSEs checking for crashed replicas" split_words:"true"`
+	// ExpireControllerEnabled makes the registry delete expired NSEs with a controller scheduling the expiration times
+	// stored in the NSEs from an informer cache, so expirations survive restarts of the registry
+	ExpireControllerEnabled bool `default:"false" desc:"delete expired NSEs using an informer-driven expire controller" split_words:"true"`
+	ExpireControllerWorkers int  `default:"4" desc:"number of NSEs deleted concurrently by the expire controller" split_words:"true"`
+	// Retry* define the backoff of retried k8s API calls: NSE deletes of GC sweeps and deletes retried on conflicts
+	RetryInitialInterval time.Duration `default:"10ms" desc:"delay before the first retry of a k8s API call" split_words:"true"`
+	RetryMultiplier      float64       `default:"2" desc:"factor the delay between retries of a k8s API call grows by" split_words:"true"`
+	RetryMaxInterval     time.Duration `default:"1s" desc:"maximum delay between retries of a k8s API call" split_words:"true"`
+	RetryMaxElapsedTime  time.Duration `default:"5s" desc:"time after which a k8s API call is not retried anymore" split_words:"true"`
+	// CircuitBreaker* make NSE and NS calls fail fast with Unavailable while the API server is overloaded or unreachable
+	CircuitBreakerFailureThreshold int           `default:"0" desc:"number of consecutive failed k8s API calls opening the circuit breaker, 0 disables it" split_words:"true"`
+	CircuitBreakerOpenTimeout      time.Duration `default:"1s" desc:"time the circuit breaker stays open before probing the k8s API" split_words:"true"`
+	CircuitBreakerMaxOpenTimeout   time.Duration `default:"30s" desc:"maximum time the circuit breaker stays open after failed probes" split_words:"true"`
+	// PriorityQueueing replaces the k8s client rate limiter of NSE and NS calls with one serving writes before the
+	// Lists and Watches of Find, so registrations and expirations are not delayed by read-heavy traffic
+	PriorityQueueing bool `default:"false" desc:"serve NSE and NS writes before reads when the k8s client rate limiter saturates" split_words:"true"`
+	// RBACCheck reviews the RBAC permissions for NSEs and NSs on startup. With exit the registry exits listing the
+	// missing permissions, with unready the affected subsystems are not serving until the permissions are granted.
+	RBACCheck string `default:"off" desc:"check of the RBAC permissions for NSEs and NSs on startup: off, exit or unready" split_words:"true"`
+	// Kubeconfig and KubeContext make the registry run out of the cluster, e.g. on a laptop against a kind cluster.
+	// If both are empty, the in-cluster config is used when available.
+	Kubeconfig  string `desc:"path of the kubeconfig used out of the cluster, empty uses KUBECONFIG or ~/.kube/config" split_words:"true"`
+	KubeContext string `desc:"context of the kubeconfig, empty uses the current context" split_words:"true"`
+	// Namespaces are served in addition to Namespace. NSEs are stored in the namespace of the SPIFFE ID of the client
+	// registering them if it is served, Find returns NSEs and NSs of all served namespaces. * serves all namespaces.
+	Namespaces []string `desc:"namespaces of NSEs served in addition to NSM_NAMESPACE, * serves all namespaces" split_words:"true"`
+	// TenantIsolation labels NSEs with the namespace of the SPIFFE ID registering them and limits Find responses to NSEs
+	// labeled with the namespace of the SPIFFE ID of the client, except for TenantAdminSpiffeIDs
+	TenantIsolation      bool     `default:"false" desc:"return only NSEs of the tenant of the client from Find" split_words:"true"`
+	TenantLabel          string   `default:"tenant" desc:"NSE label with the tenant of the NSE" split_words:"true"`
+	TenantAdminSpiffeIDs []string `desc:"SPIFFE IDs finding NSEs of all tenants" split_words:"true"`
+	// Quota* limit the NSEs registered by a buggy or malicious NSE re-registering under new names. Registrations of new
+	// NSEs exceeding a quota are rejected with ResourceExhausted.
+	QuotaNSEsPerService   int `default:"0" desc:"maximum number of NSEs of a network service, 0 disables the quota" split_words:"true"`
+	QuotaNSEsPerNamespace int `default:"0" desc:"maximum number of NSEs of a namespace, 0 disables the quota" split_words:"true"`
+	QuotaNSEsPerSpiffeID  int `default:"0" desc:"maximum number of NSEs registered by a SPIFFE ID, 0 disables the quota" split_words:"true"`
+	// RateLimit* limit the NSE and NS requests of each mTLS peer, e.g. an NSMgr, by its SPIFFE ID with token buckets,
+	// so one misbehaving peer doesn't starve the others. Requests exceeding the limits fail with ResourceExhausted.
+	RateLimitRegisterQPS     float64 `default:"0" desc:"Register requests per second of a peer, 0 disables the limit" split_words:"true"`
+	RateLimitRegisterBurst   int     `default:"20" desc:"burst of Register requests of a peer" split_words:"true"`
+	RateLimitUnregisterQPS   float64 `default:"0" desc:"Unregister requests per second of a peer, 0 disables the limit" split_words:"true"`
+	RateLimitUnregisterBurst int     `default:"20" desc:"burst of Unregister requests of a peer" split_words:"true"`
+	RateLimitFindQPS         float64 `default:"0" desc:"Find requests per second of a peer, 0 disables the limit" split_words:"true"`
+	RateLimitFindBurst       int     `default:"20" desc:"burst of Find requests of a peer" split_words:"true"`
+	// Validation* reject registrations of malformed NSEs and NSs with InvalidArgument before they are stored, so they
+	// don't break consumers later. Empty or invalid names are always rejected, zero values disable the other rules.
+	ValidationEnabled        bool          `default:"false" desc:"reject registrations of malformed NSEs and NSs" split_words:"true"`
+	ValidationURLSchemes     []string      `default:"tcp,unix" desc:"allowed schemes of NSE URLs, empty allows any" split_words:"true"`
+	ValidationMaxExpiration  time.Duration `default:"24h" desc:"maximum time NSE expiration times are in the future" split_words:"true"`
+	ValidationMaxLabels      int           `default:"64" desc:"maximum number of labels of a network service of an NSE or an NS selector" split_words:"true"`
+	ValidationMaxLabelLength int           `default:"253" desc:"maximum length of label keys and values" split_words:"true"`
+	// PastExpiration handles registrations with expiration times in the past, which clients with skewed clocks send and
+	// which leak NSEs as soon as they are written: accept keeps them, reject fails them with InvalidArgument and clamp
+	// moves them to now + ExpirePeriod
+	PastExpiration string `default:"accept" desc:"handling of NSE expiration times in the past: accept, reject or clamp" split_words:"true"`
+	// ServerSideExpiration stores NSE expiration deadlines computed with the registry clock as the registration time +
+	// the TTL requested by the peer, measured with the expiration time of the peer token and PeerTokenLifetime, so
+	// expirations are robust to clock drift of the nodes
+	ServerSideExpiration bool          `default:"false" desc:"store NSE expiration deadlines computed with the registry clock" split_words:"true"`
+	PeerTokenLifetime    time.Duration `default:"10m" desc:"token lifetime of the peers, NSM_MAX_TOKEN_LIFETIME of NSMgrs" split_words:"true"`
+	// WriteCoalescingWindow delays NSE creates and updates by the window and sends only the last of the consecutive
+	// creates, or updates, of an NSE made within it, cutting the API server load of registrations of the same NSE
+	// repeated during NSMgr restart storms. Registrations of different NSEs still cost a write each.
+	WriteCoalescingWindow time.Duration `default:"0" desc:"window coalescing repeated writes of the same NSE, writes of different NSEs are not coalesced, 0 disables coalescing" split_words:"true"`
+	// UnregisterHoldDown delays NSE unregistrations and cancels them if the NSE registers again within the window, so
+	// crash-looping NSEs don't thrash etcd and watchers
+	UnregisterHoldDown time.Duration `default:"0" desc:"time NSE unregistrations are held down, 0 disables the hold-down" split_words:"true"`
+}
+
+// validationRules returns the rules of registered NSEs and NSs
+func (c *Config) validationRules() *validation.Rules {
+	return &validation.Rules{
+		URLSchemes:     c.ValidationURLSchemes,
+		MaxExpiration:  c.ValidationMaxExpiration,
+		MaxLabels:      c.ValidationMaxLabels,
+		MaxLabelLength: c.ValidationMaxLabelLength,
+	}
+}
+
+// rateLimited returns true if the requests of any method are rate limited
+func (c *Config) rateLimited() bool {
+	return c.RateLimitRegisterQPS > 0 || c.RateLimitUnregisterQPS > 0 || c.RateLimitFindQPS > 0
+}
+
+// quotas returns the NSE quotas
+func (c *Config) quotas() quota.Quotas {
+	return quota.Quotas{
+		PerService:   c.QuotaNSEsPerService,
+		PerNamespace: c.QuotaNSEsPerNamespace,
+		PerSpiffeID:  c.QuotaNSEsPerSpiffeID,
+	}
+}
+
+// allNamespaces returns true if NSEs of all namespaces are served
+func (c *Config) allNamespaces() bool {
+	return len(c.Namespaces) == 1 && c.Namespaces[0] == namespacesAll
+}
+
+// leaderElectionNamespace returns the namespace of the leader election Lease
+func (c *Config) leaderElectionNamespace() string {
+	if c.LeaderElectionNamespace != "" {
+		return c.LeaderElectionNamespace
+	}
+	return c.Namespace
+}
+
+// servedNamespaces returns Namespace and Namespaces without duplicates
+func (c *Config) servedNamespaces() []string {
+	namespaces := []string{c.Namespace}
+	for _, namespace := range c.Namespaces {
+		if !slices.Contains(namespaces, namespace) {
+			namespaces = append(namespaces, namespace)
+		}
+	}
+	return namespaces
+}
+
+// retryPolicy returns the backoff of retried k8s API calls
+func (c *Config) retryPolicy() *retrypolicy.Policy {
+	return &retrypolicy.Policy{
+		InitialInterval: c.RetryInitialInterval,
+		Multiplier:      c.RetryMultiplier,
+		MaxInterval:     c.RetryMaxInterval,
+		MaxElapsedTime:  c.RetryMaxElapsedTime,
+	}
+}
+
+// livenessProbeNSEName is the name of the NSE found by the liveness self Find
+const livenessProbeNSEName = "registry-k8s-liveness-probe"
+
+// namespaceEnv is the env variable of Namespace
+const namespaceEnv = "NSM_NAMESPACE"
+
+// namespacesAll is the Namespaces value serving all namespaces
+const namespacesAll = "*"
+
+const (
+	tlsModeSpire = "spire"
+	tlsModeFile  = "file"
+	// tlsModeSelfSigned generates a self-signed SVID at startup
+	tlsModeSelfSigned = "selfsigned"
+)
+
+// tlsVersions are the supported minimum TLS versions
+var tlsVersions = map[string]uint16{
+	"1.2": tls.VersionTLS12,
+	"1.3": tls.VersionTLS13,
+}
+
+// x509Source provides the X.509 SVID and trust bundles of the registry
+type x509Source interface {
+	x509svid.Source
+	x509bundle.Source
+}
+
+// supportedListenSchemes are the URL schemes grpclisten.ListenAndServe is able to listen on
+var supportedListenSchemes = []string{"unix", "tcp", "tcp4", "tcp6"}
+
+// listenSecurityParam is the query parameter of ListenOn URLs setting the security of the listener
+const listenSecurityParam = "security"
+
+const (
+	listenSecurityMTLS = "mtls"
+	// listenSecurityPlaintext serves without TLS, e.g. for sidecars on a unix socket in a shared volume
+	listenSecurityPlaintext = "plaintext"
+)
+
+// listenSecurity returns the security of the ListenOn URL, mTLS by default
+func listenSecurity(u *url.URL) string {
+	if security := u.Query().Get(listenSecurityParam); security != "" {
+		return security
+	}
+	return listenSecurityMTLS
+}
+
+// plaintextListeners returns true if any of ListenOn is served without TLS
+func (c *Config) plaintextListeners() bool {
+	for i := range c.ListenOn {
+		if listenSecurity(&c.ListenOn[i]) == listenSecurityPlaintext {
+			return true
+		}
+	}
+	return false
+}
+
+const (
+	logFormatText = "text"
+	logFormatJSON = "json"
+)
+
+const (
+	updateModeUpdate = "update"
+	updateModeApply  = "apply"
+)
+
+const (
+	rbacCheckOff     = "off"
+	rbacCheckExit    = "exit"
+	rbacCheckUnready = "unready"
+)
+
+const (
+	pastExpirationAccept = "accept"
+	pastExpirationReject = "reject"
+	pastExpirationClamp  = "clamp"
+)
+
+// Validate checks that the configuration values are consistent and normalizes IPv6 literals of the listen and proxy
+// registry URLs
+func (c *Config) Validate() error {
+	if _, err := expiration.ParseMode(c.ExpirationParseMode); err != nil {
+		return err
+	}
+	if c.UpdateMode != updateModeUpdate && c.UpdateMode != updateModeApply {
+		return errors.Errorf("unknown update mode %q, supported modes: %s, %s", c.UpdateMode, updateModeUpdate, updateModeApply)
+	}
+	for _, u := range append(slices.Clone(c.ListenOn), c.InsecureListenOn...) {
+		if !slices.Contains(supportedListenSchemes, u.Scheme) {
+			return errors.Errorf("unsupported scheme %q in listen on URL %s, supported schemes: %s",
+				u.Scheme, u.String(), strings.Join(supportedListenSchemes, ", "))
+		}
+	}
+	for i := range c.ListenOn {
+		if err := ipurl.Normalize(&c.ListenOn[i]); err != nil {
+			return err
+		}
+	}
+	for i := range c.InsecureListenOn {
+		if err := ipurl.Normalize(&c.InsecureListenOn[i]); err != nil {
+			return err
+		}
+	}
+	if c.ProxyRegistryURL != nil {
+		if err := ipurl.Normalize(c.ProxyRegistryURL); err != nil {
+			return err
+		}
+	}
+	for i := range c.ListenOn {
+		switch security := listenSecurity(&c.ListenOn[i]); {
+		case security == listenSecurityPlaintext && c.ListenOn[i].Scheme != "unix":
+			return errors.Errorf("listen on URL %s: %s security is only supported for unix sockets, use insecure listen on for development",
+				c.ListenOn[i].String(), listenSecurityPlaintext)
+		case security != listenSecurityMTLS && security != listenSecurityPlaintext:
+			return errors.Errorf("unknown security %q in listen on URL %s, supported securities: %s, %s",
+				security, c.ListenOn[i].String(), listenSecurityMTLS, listenSecurityPlaintext)
+		}
+	}
+	if c.GCInterval < 0 {
+		return errors.Errorf("GC interval must not be negative: %v", c.GCInterval)
+	}
+	if c.LeaderElection && c.Sharding {
+		return errors.New("leader election and sharding are mutually exclusive")
+	}
+	if c.LeaderElection && (c.leaderElectionNamespace() == "" || c.LeaderElectionLeaseName == "") {
+		return errors.Errorf("leader election requires the Lease namespace and name: %q, %q", c.leaderElectionNamespace(), c.LeaderElectionLeaseName)
+	}
+	if (c.Sharding || c.AdoptionEnabled) && c.ShardLeaseDuration < 3*time.Second {
+		return errors.Errorf("shard lease duration must be at least 3s: %v", c.ShardLeaseDuration)
+	}
+	if c.HealthCheckInterval <= 0 || c.HealthCheckTimeout <= 0 {
+		return errors.Errorf("health check interval and timeout must be positive: %v, %v", c.HealthCheckInterval, c.HealthCheckTimeout)
+	}
+	if c.LivenessSelfFind && (c.ProbeListenOn == "" || c.LivenessSelfFindTimeout <= 0) {
+		return errors.Errorf("liveness self Find requires the probe listener and a positive timeout: %q, %v", c.ProbeListenOn, c.LivenessSelfFindTimeout)
+	}
+	if c.AdminReadTimeout < 0 || c.AdminWriteTimeout < 0 || c.AdminIdleTimeout < 0 {
+		return errors.Errorf("admin read, write and idle timeouts must not be negative: %v, %v, %v", c.AdminReadTimeout, c.AdminWriteTimeout, c.AdminIdleTimeout)
+	}
+	if c.LivenessSelfFind && c.AdminWriteTimeout > 0 && c.LivenessSelfFindTimeout >= c.AdminWriteTimeout {
+		return errors.Errorf("liveness self Find timeout must be less than the admin write timeout: %v, %v", c.LivenessSelfFindTimeout, c.AdminWriteTimeout)
+	}
+	if c.GCWorkers <= 0 {
+		return errors.Errorf("GC workers must be positive: %d", c.GCWorkers)
+	}
+	if c.ListPageSize < 0 {
+		return errors.Errorf("list page size must not be negative: %d", c.ListPageSize)
+	}
+	if c.KubeletBurst < 0 {
+		return errors.Errorf("kubelet burst must not be negative: %d", c.KubeletBurst)
+	}
+	switch c.TLSMode {
+	case tlsModeSpire:
+	case tlsModeSelfSigned:
+		if _, err := spiffeid.FromString(c.TLSSelfSignedSpiffeID); err != nil {
+			return errors.Wrapf(err, "invalid self-signed SPIFFE ID %q", c.TLSSelfSignedSpiffeID)
+		}
+	case tlsModeFile:
+		if c.TLSCertFile == "" || c.TLSKeyFile == "" || c.TLSCAFile == "" {
+			return errors.Errorf("TLS cert, key and CA files are required in the %s TLS mode", tlsModeFile)
+		}
+	default:
+		return errors.Errorf("unknown TLS mode %q, supported modes: %s, %s, %s", c.TLSMode, tlsModeSpire, tlsModeFile, tlsModeSelfSigned)
+	}
+	// The Prometheus listener gets its SVID from the Workload API itself
+	if c.TLSMode != tlsModeSpire && prometheus.IsEnabled() {
+		return errors.Errorf("the Prometheus listener requires the SPIFFE Workload API, it can't be used in the %s TLS mode", c.TLSMode)
+	}
+	if _, ok := tlsVersions[c.TLSMinVersion]; !ok {
+		return errors.Errorf("unsupported minimum TLS version %q, supported versions: 1.2, 1.3", c.TLSMinVersion)
+	}
+	if _, err := cipherSuites(c.TLSCipherSuites); err != nil {
+		return err
+	}
+	if c.TLSMinVersion == "1.3" && len(c.TLSCipherSuites) > 0 {
+		return errors.New("TLS cipher suites can't be configured with the minimum TLS version 1.3")
+	}
+	for _, td := range c.AllowedTrustDomains {
+		if _, err := spiffeid.TrustDomainFromString(td); err != nil {
+			return errors.Wrapf(err, "invalid allowed trust domain %q", td)
+		}
+	}
+	if c.DialTimeout <= 0 || c.RequestTimeout < 0 {
+		return errors.Errorf("dial timeout must be positive and request timeout must not be negative: %v, %v", c.DialTimeout, c.RequestTimeout)
+	}
+	if c.DefaultExpiration < 0 || c.MaxExpiration < 0 {
+		return errors.Errorf("default and max expiration must not be negative: %v, %v", c.DefaultExpiration, c.MaxExpiration)
+	}
+	if c.UnregisterHoldDown < 0 {
+		return errors.Errorf("unregister hold-down must not be negative: %v", c.UnregisterHoldDown)
+	}
+	if c.WriteCoalescingWindow < 0 {
+		return errors.Errorf("write coalescing window must not be negative: %v", c.WriteCoalescingWindow)
+	}
+	if c.ServerSideExpiration && c.PeerTokenLifetime <= 0 {
+		return errors.Errorf("peer token lifetime must be positive: %v", c.PeerTokenLifetime)
+	}
+	if c.PastExpiration != pastExpirationAccept && c.PastExpiration != pastExpirationReject && c.PastExpiration != pastExpirationClamp {
+		return errors.Errorf("unknown past expiration handling %q, supported handlings: %s, %s, %s", c.PastExpiration,
+			pastExpirationAccept, pastExpirationReject, pastExpirationClamp)
+	}
+	if c.MaxExpiration > 0 && c.DefaultExpiration > c.MaxExpiration {
+		return errors.Errorf("default expiration %v exceeds max expiration %v", c.DefaultExpiration, c.MaxExpiration)
+	}
+	if c.SVIDWaitTimeout <= 0 {
+		return errors.Errorf("SVID wait timeout must be positive: %v", c.SVIDWaitTimeout)
+	}
+	if c.RBACCheck != rbacCheckOff && c.RBACCheck != rbacCheckExit && c.RBACCheck != rbacCheckUnready {
+		return errors.Errorf("unknown RBAC check %q, supported checks: %s, %s, %s", c.RBACCheck, rbacCheckOff, rbacCheckExit, rbacCheckUnready)
+	}
+	if c.LogFormat != logFormatText && c.LogFormat != logFormatJSON {
+		return errors.Errorf("unknown log format %q, supported formats: %s, %s", c.LogFormat, logFormatText, logFormatJSON)
+	}
+	if err := c.otlpConfig().Validate(); err != nil {
+		return err
+	}
+	if c.MetricsExportInterval <= 0 {
+		return errors.Errorf("metrics export interval must be positive: %v", c.MetricsExportInterval)
+	}
+	if c.NseTTLMetric && !c.UseInformerCache {
+		return errors.New("NSE TTL metric lists all NSEs on each collection, it requires the informer cache")
+	}
+	if c.OtelRequired && c.OtelRequiredTimeout <= 0 {
+		return errors.Errorf("OpenTelemetry required timeout must be positive: %v", c.OtelRequiredTimeout)
+	}
+	if c.TraceSamplingRatio < 0 || c.TraceSamplingRatio > 1 {
+		return errors.Errorf("trace sampling ratio must be between 0 and 1: %v", c.TraceSamplingRatio)
+	}
+	if c.KeepaliveTime <= 0 || c.KeepaliveTimeout <= 0 || c.KeepaliveMinTime < 0 {
+		return errors.Errorf("keepalive time and timeout must be positive and keepalive min time must not be negative: %v, %v, %v",
+			c.KeepaliveTime, c.KeepaliveTimeout, c.KeepaliveMinTime)
+	}
+	if c.MaxConnectionAge < 0 || c.MaxConnectionAgeGrace < 0 {
+		return errors.Errorf("max connection age and grace must not be negative: %v, %v", c.MaxConnectionAge, c.MaxConnectionAgeGrace)
+	}
+	if c.MaxRecvMsgSize <= 0 || c.MaxSendMsgSize <= 0 {
+		return errors.Errorf("max receive and send message sizes must be positive: %d, %d", c.MaxRecvMsgSize, c.MaxSendMsgSize)
+	}
+	if c.MaxConnections < 0 {
+		return errors.Errorf("max connections must not be negative: %d", c.MaxConnections)
+	}
+	if c.SocketCleanup != grpclisten.CleanupAlways && c.SocketCleanup != grpclisten.CleanupStale && c.SocketCleanup != grpclisten.CleanupNever {
+		return errors.Errorf("unknown socket cleanup %q, supported cleanups: %s, %s, %s",
+			c.SocketCleanup, grpclisten.CleanupAlways, grpclisten.CleanupStale, grpclisten.CleanupNever)
+	}
+	if c.SocketFileMode&^os.ModePerm != 0 {
+		return errors.Errorf("socket file mode must only have permission bits: %#o", uint32(c.SocketFileMode))
+	}
+	if c.UpstreamCompression != "" && c.UpstreamCompression != gzip.Name {
+		return errors.Errorf("unknown upstream compression %q, supported compressions: %s", c.UpstreamCompression, gzip.Name)
+	}
+	if c.HandoffEnabled && c.HandoffTimeout <= 0 {
+		return errors.Errorf("handoff timeout must be positive: %v", c.HandoffTimeout)
+	}
+	if c.AdoptionEnabled && c.AdoptionResyncInterval <= 0 {
+		return errors.Errorf("adoption resync interval must be positive: %v", c.AdoptionResyncInterval)
+	}
+	if c.ExpireControllerEnabled && c.ExpiryQueueEnabled {
+		return errors.New("the expire controller and the expiry queue must not be enabled together")
+	}
+	if c.ExpireControllerEnabled && c.ExpireControllerWorkers <= 0 {
+		return errors.Errorf("expire controller workers must be positive: %d", c.ExpireControllerWorkers)
+	}
+	if err := c.retryPolicy().Validate(); err != nil {
+		return err
+	}
+	for _, namespace := range c.Namespaces {
+		if namespace == "" || (namespace == namespacesAll && len(c.Namespaces) > 1) {
+			return errors.Errorf("namespaces must be non-empty names or %s alone: %v", namespacesAll, c.Namespaces)
+		}
+	}
+	if c.allNamespaces() && (c.ExpiryQueueEnabled || c.ExpireControllerEnabled) {
+		return errors.Errorf("the expiry queue and the expire controller are not supported with namespaces %s", namespacesAll)
+	}
+	if len(c.Namespaces) > 0 && (c.HandoffEnabled || c.AdoptionEnabled) {
+		return errors.New("handoff and adoption are not supported with namespaces")
+	}
+	if c.TenantIsolation && c.TenantLabel == "" {
+		return errors.New("tenant label must not be empty")
+	}
+	if c.QuotaNSEsPerService < 0 || c.QuotaNSEsPerNamespace < 0 || c.QuotaNSEsPerSpiffeID < 0 {
+		return errors.Errorf("NSE quotas must not be negative: %d, %d, %d", c.QuotaNSEsPerService, c.QuotaNSEsPerNamespace, c.QuotaNSEsPerSpiffeID)
+	}
+	if c.QuotaNSEsPerSpiffeID > 0 && !c.OwnershipEnforcement {
+		return errors.New("the NSE quota per SPIFFE ID requires ownership enforcement")
+	}
+	if c.RateLimitRegisterQPS < 0 || c.RateLimitUnregisterQPS < 0 || c.RateLimitFindQPS < 0 {
+		return errors.Errorf("rate limits must not be negative: %v, %v, %v", c.RateLimitRegisterQPS, c.RateLimitUnregisterQPS, c.RateLimitFindQPS)
+	}
+	if (c.RateLimitRegisterQPS > 0 && c.RateLimitRegisterBurst <= 0) ||
+		(c.RateLimitUnregisterQPS > 0 && c.RateLimitUnregisterBurst <= 0) ||
+		(c.RateLimitFindQPS > 0 && c.RateLimitFindBurst <= 0) {
+		return errors.Errorf("rate limit bursts must be positive: %d, %d, %d", c.RateLimitRegisterBurst, c.RateLimitUnregisterBurst, c.RateLimitFindBurst)
+	}
+	if c.ValidationMaxExpiration < 0 || c.ValidationMaxLabels < 0 || c.ValidationMaxLabelLength < 0 {
+		return errors.Errorf("validation limits must not be negative: %v, %d, %d", c.ValidationMaxExpiration, c.ValidationMaxLabels, c.ValidationMaxLabelLength)
+	}
+	if c.CircuitBreakerFailureThreshold < 0 {
+		return errors.Errorf("circuit breaker failure threshold must not be negative: %d", c.CircuitBreakerFailureThreshold)
+	}
+	if c.CircuitBreakerFailureThreshold > 0 && (c.CircuitBreakerOpenTimeout <= 0 || c.CircuitBreakerMaxOpenTimeout < c.CircuitBreakerOpenTimeout) {
+		return errors.Errorf("circuit breaker open timeout must be positive and not greater than the max open timeout: %v, %v",
+			c.CircuitBreakerOpenTimeout, c.CircuitBreakerMaxOpenTimeout)
+	}
+	if c.SlowRequestThreshold < 0 {
+		return errors.Errorf("slow request threshold must not be negative: %v", c.SlowRequestThreshold)
+	}
+	if c.LogThrottleInterval < 0 {
+		return errors.Errorf("log throttle interval must not be negative: %v", c.LogThrottleInterval)
+	}
+	if c.ReadBufferSize <= 0 {
+		return errors.Errorf("read buffer size must be positive: %d", c.ReadBufferSize)
+	}
+	if c.WriteBufferSize <= 0 {
+		return errors.Errorf("write buffer size must be positive: %d", c.WriteBufferSize)
+	}
+	return nil
+}
+
+// otlpConfig returns the configuration of the OTLP exporters
+func (c *Config) otlpConfig() *otlpexport.Config {
+	return &otlpexport.Config{
+		Endpoint: c.OpenTelemetryEndpoint,
+		Protocol: c.OtelExporterProtocol,
+		Insecure: c.OtelExporterInsecure,
+		CAFile:   c.OtelExporterCAFile,
+		CertFile: c.OtelExporterCertFile,
+		KeyFile:  c.OtelExporterKeyFile,
+		Headers:  c.OtelExporterHeaders,
+	}
+}
+
+// chainElementTiming returns true if chain elements and k8s API calls are timed, for metrics or slow request logs
+func (c *Config) chainElementTiming() bool {
+	return c.ChainElementMetrics || c.SlowRequestThreshold > 0
+}
+
+// checkSVIDValidity returns an error if the SVID expires in less than minValidity
+func checkSVIDValidity(svid *x509svid.SVID, minValidity time.Duration) error {
+	if validity := time.Until(svid.Certificates[0].NotAfter); validity < minValidity {
+		return errors.Errorf("SVID %q is valid for %v, required at least %v", svid.ID, validity, minValidity)
+	}
+	return nil
+}
+
+// tlsConfigs returns the mTLS server and client configs. They fetch the SVID and the trust bundle from the source on
+// each handshake, so rotated certificates and trust bundles are picked up without restart. The TLS settings must have
+// been validated by Config.Validate.
+func (c *Config) tlsConfigs(source x509Source) (server, client *tls.Config) {
+	peerAuthorizer := authorizeTrustDomains(c.AllowedTrustDomains)
+	minVersion := tlsVersions[c.TLSMinVersion]
+	suites, _ := cipherSuites(c.TLSCipherSuites)
+
+	server = tlsconfig.MTLSServerConfig(source, source, peerAuthorizer)
+	server.MinVersion = minVersion
+	server.CipherSuites = suites
+	client = tlsconfig.MTLSClientConfig(source, source, peerAuthorizer)
+	client.MinVersion = minVersion
+	client.CipherSuites = suites
+	return server, client
 }
 
 func main() {
 	var config = new(Config)
-	// Setup context to catch signals
-	ctx, cancel := signal.NotifyContext(
-		context.Background(),
+	// Setup context to catch signals, the cause of the context keeps the shutdown reason
+	ctx, cancel := context.WithCancelCause(context.Background())
+	defer cancel(nil)
+	signalCh := make(chan os.Signal, 1)
+	signal.Notify(signalCh,
 		os.Interrupt,
 		// More Linux signals here
 		syscall.SIGHUP,
 		syscall.SIGTERM,
 		syscall.SIGQUIT,
 	)
-	defer cancel()
+	go func() {
+		select {
+		case sig := <-signalCh:
+			cancel(errors.Errorf("received signal %v", sig))
+		case <-ctx.Done():
+		}
+		signal.Stop(signalCh)
+	}()
 
 	// Setup logging
-	log.EnableTracing(true)
 	logrus.SetFormatter(&nested.Formatter{})
 	ctx = log.WithLog(ctx, logruslogger.New(ctx, map[string]interface{}{"cmd": os.Args[0]}))
 
@@ -100,6 +835,7 @@ func main() {
 	}
 
 	startTime := time.Now()
+	log.FromContext(ctx).WithField("event", "startup").WithField("pid", os.Getpid()).Infof("Starting %s", os.Args[0])
 
 	// Get config from environment
 	if err := envconfig.Usage("nsm", config); err != nil {
@@ -108,24 +844,85 @@ func main() {
 	if err := envconfig.Process("nsm", config); err != nil {
 		logrus.Fatalf("error processing config from env: %+v", err)
 	}
+	if err := config.Validate(); err != nil {
+		logrus.Fatalf("invalid config: %+v", err)
+	}
+	// The namespace of the service account or the kubeconfig context is used unless the namespace is set explicitly
+	restConfig, kubeNamespace, err := kubeconfig.Load(config.Kubeconfig, config.KubeContext)
+	if err != nil {
+		logrus.Fatalf("error loading the k8s client config: %+v", err)
+	}
+	_, namespaceSet := os.LookupEnv(namespaceEnv)
+	if !namespaceSet && kubeNamespace != "" {
+		config.Namespace = kubeNamespace
+	}
 
 	l, err := logrus.ParseLevel(config.LogLevel)
 	if err != nil {
 		logrus.Fatalf("invalid log level %s", config.LogLevel)
 	}
 	logrus.SetLevel(l)
-	log.FromContext(ctx).Infof("Config: %#v", config)
+	log.EnableTracing(config.TracingEnabled)
+	if config.LogFormat == logFormatJSON {
+		logrus.SetFormatter(jsonlog.New(config.Namespace))
+	}
+	if config.LogThrottleInterval > 0 {
+		throttle := logthrottle.New(logrus.StandardLogger().Formatter, config.LogThrottleInterval)
+		logrus.SetFormatter(throttle)
+		go throttle.Run(ctx, logrus.StandardLogger())
+	}
+	if config.LogFormat == logFormatJSON || config.LogThrottleInterval > 0 {
+		// Trace loggers reset the formatter of the standard logger unless the global logger is set
+		log.SetGlobalLogger(log.FromContext(ctx))
+	}
+	redactedConfig := redact.Sprint(config)
+	log.FromContext(ctx).Infof("Config: %s", redactedConfig)
+	switch {
+	case namespaceSet:
+		log.FromContext(ctx).Infof("Serving NSEs and NSs of namespace %s set by %s", config.Namespace, namespaceEnv)
+	case kubeNamespace != "":
+		log.FromContext(ctx).Infof("Serving NSEs and NSs of namespace %s detected from the service account or kubeconfig context", config.Namespace)
+	default:
+		log.FromContext(ctx).Warnf("Serving NSEs and NSs of namespace %s, the namespace is neither set by %s nor detected", config.Namespace, namespaceEnv)
+	}
 	logruslogger.SetupLevelChangeOnSignal(ctx, map[os.Signal]logrus.Level{
 		syscall.SIGUSR1: logrus.TraceLevel,
 		syscall.SIGUSR2: l,
 	})
+	if config.LogLevelFile != "" {
+		if err = loglevel.WatchFile(ctx, config.LogLevelFile); err != nil {
+			logrus.Fatalf("error watching log level file: %+v", err)
+		}
+	}
 
 	// Configure Open Telemetry
 	if opentelemetry.IsEnabled() {
-		collectorAddress := config.OpenTelemetryEndpoint
-		spanExporter := opentelemetry.InitSpanExporter(ctx, collectorAddress)
-		metricExporter := opentelemetry.InitOPTLMetricExporter(ctx, collectorAddress, config.MetricsExportInterval)
-		o := opentelemetry.Init(ctx, spanExporter, metricExporter, "registry-k8s")
+		var spanExporter sdktrace.SpanExporter
+		spanExporter, err = otlpexport.NewSpanExporter(ctx, config.otlpConfig())
+		if err != nil {
+			log.FromContext(ctx).Errorf("%v", err)
+		}
+		var metricExporter sdkmetric.Reader
+		if prometheus.IsEnabled() {
+			metricExporter = opentelemetry.InitPrometheusMetricExporter(ctx)
+		} else if metricExporter, err = otlpexport.NewMetricReader(ctx, config.otlpConfig(), config.MetricsExportInterval); err != nil {
+			log.FromContext(ctx).Errorf("%v", err)
+		}
+		if config.OtelRequired {
+			checkOtelExporters(ctx, config, spanExporter, metricExporter)
+		}
+		resourceOptions := []resource.Option{
+			resource.WithAttributes(semconv.ServiceNameKey.String("registry-k8s")),
+			resource.WithFromEnv(),
+		}
+		if config.OtelK8sAttributes {
+			resourceOptions = append(resourceOptions, resource.WithAttributes(k8sResourceAttributes()...))
+		}
+		res, resErr := resource.New(ctx, resourceOptions...)
+		if resErr != nil {
+			log.FromContext(ctx).Errorf("failed to create OpenTelemetry resource: %v", resErr)
+		}
+		o := otelprovider.Init(ctx, spanExporter, metricExporter, res)
 		defer func() {
 			if err = o.Close(); err != nil {
 				log.FromContext(ctx).Error(err.Error())
@@ -133,13 +930,29 @@ func main() {
 		}()
 	}
 
+	// Configure Prometheus
+	if prometheus.IsEnabled() {
+		go prometheus.ListenAndServe(ctx, config.PrometheusListenOn, config.PrometheusServerHeaderTimeout, func() {
+			cancel(errors.New("prometheus server failed"))
+		})
+	}
+
 	// Configure pprof
 	if config.PprofEnabled {
 		go pprofutils.ListenAndServe(ctx, config.PprofListenOn)
 	}
 
 	// Get a X509Source
-	source, err := workloadapi.NewX509Source(ctx)
+	var source x509Source
+	switch config.TLSMode {
+	case tlsModeFile:
+		source, err = filesource.New(ctx, config.TLSCertFile, config.TLSKeyFile, config.TLSCAFile)
+	case tlsModeSelfSigned:
+		log.FromContext(ctx).Warnf("Using a self-signed SVID, peers can't verify the registry identity. Don't use it in production")
+		source, err = selfsigned.New(spiffeid.RequireFromString(config.TLSSelfSignedSpiffeID))
+	default:
+		source, err = newWorkloadAPISource(ctx, config.WorkloadAPIAddress, config.SVIDWaitTimeout, config.SVIDWaitRetry)
+	}
 	if err != nil {
 		logrus.Fatalf("error getting x509 source: %+v", err)
 	}
@@ -148,60 +961,648 @@ func main() {
 		logrus.Fatalf("error getting x509 svid: %+v", err)
 	}
 	logrus.Infof("SVID: %q", svid.ID)
+	logrus.Infof("SVID expires at %v", svid.Certificates[0].NotAfter)
+	if err = checkSVIDValidity(svid, config.MinSVIDValidity); err != nil {
+		logrus.Fatal(err)
+	}
 
-	tlsClientConfig := tlsconfig.MTLSClientConfig(source, source, tlsconfig.AuthorizeAny())
-	tlsClientConfig.MinVersion = tls.VersionTLS12
-	tlsServerConfig := tlsconfig.MTLSServerConfig(source, source, tlsconfig.AuthorizeAny())
-	tlsServerConfig.MinVersion = tls.VersionTLS12
+	tlsServerConfig, tlsClientConfig := config.tlsConfigs(source)
 
 	credsTLS := credentials.NewTLS(tlsServerConfig)
 	// Create GRPC Server and register services
-	serverOptions := append(tracing.WithTracing(), grpc.Creds(credsTLS))
-	server := grpc.NewServer(serverOptions...)
+	serverOptions := append(
+		tracing.WithTracing(),
+		grpc.ReadBufferSize(config.ReadBufferSize),
+		grpc.WriteBufferSize(config.WriteBufferSize),
+		grpc.KeepaliveParams(keepalive.ServerParameters{
+			Time:                  config.KeepaliveTime,
+			Timeout:               config.KeepaliveTimeout,
+			MaxConnectionAge:      config.MaxConnectionAge,
+			MaxConnectionAgeGrace: config.MaxConnectionAgeGrace,
+		}),
+		grpc.KeepaliveEnforcementPolicy(keepalive.EnforcementPolicy{
+			MinTime:             config.KeepaliveMinTime,
+			PermitWithoutStream: config.KeepalivePermitWithoutStream,
+		}),
+		grpc.MaxRecvMsgSize(config.MaxRecvMsgSize),
+		grpc.MaxSendMsgSize(config.MaxSendMsgSize),
+	)
+	if config.MaxConcurrentStreams > 0 {
+		serverOptions = append(serverOptions, grpc.MaxConcurrentStreams(config.MaxConcurrentStreams))
+	}
+	if config.TracingEnabled && config.TraceSamplingRatio < 1 {
+		serverOptions = append(serverOptions,
+			grpc.ChainUnaryInterceptor(tracesampling.UnaryServerInterceptor(config.TraceSamplingRatio)),
+			grpc.ChainStreamInterceptor(tracesampling.StreamServerInterceptor(config.TraceSamplingRatio)),
+		)
+	}
+	if config.MapK8sErrorCodes {
+		serverOptions = append(serverOptions,
+			grpc.ChainUnaryInterceptor(k8serrors.UnaryServerInterceptor()),
+			grpc.ChainStreamInterceptor(k8serrors.StreamServerInterceptor()),
+		)
+	}
+	server := grpc.NewServer(append(serverOptions, grpc.Creds(credsTLS))...)
+	servers := []*grpc.Server{server}
+	var insecureServer *grpc.Server
+	if len(config.InsecureListenOn) > 0 || config.plaintextListeners() {
+		insecureServer = grpc.NewServer(serverOptions...)
+		servers = append(servers, insecureServer)
+	}
 
 	clientOptions := append(
 		tracing.WithTracingDial(),
 		grpc.WithBlock(),
 		grpc.WithDefaultCallOptions(
 			grpc.WaitForReady(true),
+			grpc.MaxCallRecvMsgSize(config.MaxRecvMsgSize),
+			grpc.MaxCallSendMsgSize(config.MaxSendMsgSize),
 			grpc.PerRPCCredentials(token.NewPerRPCCredentials(spiffejwt.TokenGeneratorFunc(source, config.MaxTokenLifetime)))),
 		grpc.WithTransportCredentials(
 			grpcfd.TransportCredentials(credentials.NewTLS(tlsClientConfig))),
 		grpcfd.WithChainStreamInterceptor(),
 		grpcfd.WithChainUnaryInterceptor(),
+		grpc.WithReadBufferSize(config.ReadBufferSize),
+		grpc.WithWriteBufferSize(config.WriteBufferSize),
+		grpc.WithConnectParams(grpc.ConnectParams{
+			Backoff:           backoff.DefaultConfig,
+			MinConnectTimeout: config.DialTimeout,
+		}),
 	)
+	if config.UpstreamCompression != "" {
+		clientOptions = append(clientOptions, grpc.WithDefaultCallOptions(grpc.UseCompressor(config.UpstreamCompression)))
+	}
+	if config.RequestTimeout > 0 {
+		clientOptions = append(clientOptions, grpc.WithChainUnaryInterceptor(calltimeout.UnaryClientInterceptor(config.RequestTimeout)))
+	}
+	log.FromContext(ctx).Infof("gRPC buffer sizes: read %d bytes, write %d bytes", config.ReadBufferSize, config.WriteBufferSize)
+	log.FromContext(ctx).Infof("gRPC limits: max receive message size %d bytes, max send message size %d bytes, max concurrent streams %d, max connections %d",
+		config.MaxRecvMsgSize, config.MaxSendMsgSize, config.MaxConcurrentStreams, config.MaxConnections)
+	log.FromContext(ctx).Infof("gRPC keepalive: time %v, timeout %v, min client ping interval %v, max connection age %v, grace %v",
+		config.KeepaliveTime, config.KeepaliveTimeout, config.KeepaliveMinTime, config.MaxConnectionAge, config.MaxConnectionAgeGrace)
 
 	// Adjust config and create ClientSet
-	client, _, err := k8s.NewVersionedClient(
-		k8s.WithQPS(float32(config.KubeletQPS)),
-		k8s.WithBurst(config.KubeletQPS*2))
+	k8smetrics.RegisterClientMetrics(config.Namespace)
+	burst := config.KubeletBurst
+	if burst == 0 {
+		burst = config.KubeletQPS * 2
+	}
+	restConfig.QPS = float32(config.KubeletQPS)
+	restConfig.Burst = burst
+	var client versioned.Interface
+	client, err = versioned.NewForConfig(restConfig)
 	if err != nil {
 		logrus.Fatalf("error creating NewVersionedClient: %+v", err)
 	}
+	log.FromContext(ctx).Infof("k8s client rate limits: QPS %v, burst %d", restConfig.QPS, restConfig.Burst)
+	if config.PriorityQueueing {
+		// The rate limit is applied by the priority clientset instead of the client
+		unlimitedConfig := rest.CopyConfig(restConfig)
+		unlimitedConfig.QPS = -1
+		var unlimitedClient versioned.Interface
+		unlimitedClient, err = versioned.NewForConfig(unlimitedConfig)
+		if err != nil {
+			logrus.Fatalf("error creating NewVersionedClient: %+v", err)
+		}
+		client = priority.NewClientSet(ctx, unlimitedClient, restConfig.QPS, restConfig.Burst)
+	}
+
+	if config.ForceApply {
+		log.FromContext(ctx).Warnf("Force apply is enabled, NSE and NS fields owned by other field managers may be overridden")
+	}
+	if config.CircuitBreakerFailureThreshold > 0 {
+		client = circuitbreaker.NewClientSet(ctx, client, config.CircuitBreakerFailureThreshold,
+			config.CircuitBreakerOpenTimeout, config.CircuitBreakerMaxOpenTimeout)
+	}
+	if config.chainElementTiming() {
+		client = clienttiming.NewClientSet(client)
+	}
+	client = fieldmanager.NewClientSet(client, config.FieldManager, config.ForceApply)
+	if config.OwnershipEnforcement {
+		client = registeredby.NewClientSet(client)
+	}
+	if config.UpdateMode == updateModeApply {
+		client = applyupdate.NewClientSet(client)
+	}
+	identity, _ := os.Hostname()
+	expirationMode, _ := expiration.ParseMode(config.ExpirationParseMode)
+	if config.AdoptionEnabled {
+		client = managedby.NewClientSet(client, identity, handoff.ReleasedByAnnotation, handoff.AdoptedByAnnotation)
+	}
+	client = conflictretry.NewClientSet(client, config.retryPolicy(), expirationMode)
+	client = idempotentdelete.NewClientSet(client)
+	if config.ListPageSize > 0 {
+		client = pagination.NewClientSet(client, config.ListPageSize)
+	}
+	if config.UseInformerCache {
+		client = informercache.NewClientSet(ctx, client)
+	}
+	if config.WriteCoalescingWindow > 0 {
+		client = coalesce.NewClientSet(client, config.WriteCoalescingWindow)
+	}
+	// The routing client is the outermost one, so Finds served from the informer cache are limited to the namespaces too
+	var routedNamespaces []string
+	if !config.allNamespaces() {
+		routedNamespaces = config.servedNamespaces()
+	}
+	if len(config.Namespaces) > 0 {
+		client = multinamespace.NewClientSet(client, config.Namespace, routedNamespaces)
+	}
 
 	config.ClientSet = client
+	var objectCountOptions []k8smetrics.Option
+	if config.NseTTLMetric {
+		objectCountOptions = append(objectCountOptions, k8smetrics.WithNSETTL())
+	}
+	k8smetrics.RegisterObjectCounts(client, config.Namespace, objectCountOptions...)
 	config.ChainCtx = ctx
+	var kubeClient kubernetes.Interface
+	if config.LeaderElection || config.Sharding || config.AdoptionEnabled || config.RBACCheck != rbacCheckOff {
+		kubeClient, err = kubernetes.NewForConfig(restConfig)
+		if err != nil {
+			logrus.Fatalf("error creating kubernetes client: %+v", err)
+		}
+	}
+	var rbacChecker *rbaccheck.Checker
+	if config.RBACCheck != rbacCheckOff {
+		rbacChecker = rbaccheck.New(kubeClient, config.Namespace)
+		for _, resource := range []string{rbaccheck.NetworkServiceEndpoints, rbaccheck.NetworkServices} {
+			checkCtx, cancel := context.WithTimeout(ctx, config.HealthCheckTimeout)
+			err = rbacChecker.Check(checkCtx, resource)
+			cancel()
+			if err == nil {
+				continue
+			}
+			if config.RBACCheck == rbacCheckExit {
+				log.FromContext(ctx).Fatalf("RBAC check failed: %v", err)
+			}
+			log.FromContext(ctx).Errorf("RBAC check failed, %s are not served until the permissions are granted: %v", resource, err)
+		}
+	}
+	var expiryQueueOptions []expiryqueue.Option
+	expireControllerOptions := []expirecontroller.Option{
+		expirecontroller.WithWorkers(config.ExpireControllerWorkers),
+	}
+	gcOptions := []gc.Option{
+		gc.WithWorkers(config.GCWorkers),
+		gc.WithDeleteTimeout(config.GCDeleteTimeout),
+		gc.WithRetryPolicy(config.retryPolicy()),
+	}
+	// Lists served from the informer cache are in memory already, so they aren't split into pages
+	if config.ListPageSize > 0 && !config.UseInformerCache {
+		expiryQueueOptions = append(expiryQueueOptions, expiryqueue.WithPageSize(config.ListPageSize))
+		gcOptions = append(gcOptions, gc.WithPageSize(config.ListPageSize))
+	}
+	var membership *shard.Membership
+	if config.Sharding || config.AdoptionEnabled {
+		membership = shard.NewMembership(kubeClient, config.Namespace, config.ShardGroup, identity, config.ShardLeaseDuration)
+		go membership.Run(ctx)
+	}
+	if config.Sharding {
+		expiryQueueOptions = append(expiryQueueOptions, expiryqueue.WithOwner(membership.Owns))
+		expireControllerOptions = append(expireControllerOptions, expirecontroller.WithOwner(membership.Owns))
+		gcOptions = append(gcOptions, gc.WithOwner(membership.Owns))
+	}
+	// The list of all namespaces is limited to the served namespaces by the routing client
+	gcNamespace := config.Namespace
+	if len(config.Namespaces) > 0 {
+		gcNamespace = metav1.NamespaceAll
+	}
+	var leaderOptions []leader.Option
+	if config.LeaderElection && config.LeaderElectionWarmup {
+		// A new leader sweeps the NSEs which expired while the Lease was changing hands, the regular sweeps follow after
+		// the interval
+		leaderOptions = append(leaderOptions, leader.WithWarmup(func(ctx context.Context) {
+			log.FromContext(ctx).WithField("event", "leader_warmup").Infof("Sweeping NSEs expired before the leadership was acquired")
+			gc.Sweep(ctx, client, gcNamespace, expirationMode, gcOptions...)
+		}))
+		gcOptions = append(gcOptions, gc.WithDelayedStart())
+	}
+	// Expiration jobs delete NSEs of all replicas, so with leader election only the leader runs them
+	runExpirationJobs := func(ctx context.Context) {
+		var wg sync.WaitGroup
+		for _, namespace := range config.servedNamespaces() {
+			if config.ExpiryQueueEnabled {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					expiryqueue.Run(ctx, client, namespace, expirationMode, expiryQueueOptions...)
+				}()
+			}
+			if config.ExpireControllerEnabled {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					expirecontroller.Run(ctx, client, namespace, expirationMode, expireControllerOptions...)
+				}()
+			}
+		}
+		if config.GCInterval > 0 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				gc.Run(ctx, client, gcNamespace, config.GCInterval, expirationMode, gcOptions...)
+			}()
+		}
+		wg.Wait()
+	}
+	if config.LeaderElection {
+		checkCtx, cancelCheck := context.WithTimeout(ctx, config.HealthCheckTimeout)
+		err = leader.CheckAccess(checkCtx, kubeClient, config.leaderElectionNamespace())
+		cancelCheck()
+		if err != nil {
+			log.FromContext(ctx).Fatalf("leader election is not possible: %v", err)
+		}
+		go func() {
+			err := leader.Run(ctx, kubeClient, config.leaderElectionNamespace(), config.LeaderElectionLeaseName, identity, runExpirationJobs,
+				append(leaderOptions,
+					leader.WithLeaseDuration(config.LeaderElectionLeaseDuration),
+					leader.WithRenewDeadline(config.LeaderElectionRenewDeadline),
+					leader.WithRetryPeriod(config.LeaderElectionRetryPeriod))...)
+			if err != nil {
+				log.FromContext(ctx).Fatalf("leader election failed: %+v", err)
+			}
+		}()
+	} else {
+		go runExpirationJobs(ctx)
+	}
+	var nseHandoff *handoff.Handoff
+	if config.HandoffEnabled || config.AdoptionEnabled {
+		var handoffOptions []handoff.Option
+		if config.AdoptionEnabled {
+			handoffOptions = append(handoffOptions, handoff.WithLiveness(membership.Alive, config.AdoptionResyncInterval))
+		}
+		nseHandoff = handoff.New(client, config.Namespace, identity, expirationMode, handoffOptions...)
+		go nseHandoff.Run(ctx)
+	}
+
+	// timeNSE and timeNS time the chain elements for chain element metrics and slow request logs
+	timeNSE := func(name string, server registryapi.NetworkServiceEndpointRegistryServer) registryapi.NetworkServiceEndpointRegistryServer {
+		if !config.chainElementTiming() {
+			return server
+		}
+		return timing.NewNetworkServiceEndpointRegistryServer(name, server)
+	}
+	timeNS := func(name string, server registryapi.NetworkServiceRegistryServer) registryapi.NetworkServiceRegistryServer {
+		if !config.chainElementTiming() {
+			return server
+		}
+		return timing.NewNetworkServiceRegistryServer(name, server)
+	}
 
-	registryk8s.NewServer(
+	authorizeNSEServer := timeNSE("authorize", authorize.NewNetworkServiceEndpointRegistryServer(authorize.WithPolicies(config.RegistryServerPolicies...)))
+	if len(config.Namespaces) > 0 {
+		authorizeNSEServer = chain.NewNetworkServiceEndpointRegistryServer(
+			authorizeNSEServer,
+			timeNSE("namespacerouting", namespacerouting.NewNetworkServiceEndpointRegistryServer(routedNamespaces)),
+		)
+	}
+	if config.TenantIsolation {
+		authorizeNSEServer = chain.NewNetworkServiceEndpointRegistryServer(
+			authorizeNSEServer,
+			timeNSE("tenantscope", tenantscope.NewNetworkServiceEndpointRegistryServer(config.TenantLabel, config.TenantAdminSpiffeIDs...)),
+		)
+	}
+	if config.OwnershipEnforcement {
+		authorizeNSEServer = chain.NewNetworkServiceEndpointRegistryServer(
+			authorizeNSEServer,
+			timeNSE("ownership", ownership.NewNetworkServiceEndpointRegistryServer(client, config.Namespace, config.OwnershipAdminSpiffeIDs...)),
+		)
+	}
+	if quotas := config.quotas(); quotas.Enabled() {
+		var quotaOptions []quota.Option
+		if len(config.Namespaces) > 0 {
+			quotaOptions = append(quotaOptions, quota.WithAllNamespaces())
+		}
+		quotaServer, quotaErr := quota.NewNetworkServiceEndpointRegistryServer(ctx, client, config.Namespace, quotas, quotaOptions...)
+		if quotaErr != nil {
+			log.FromContext(ctx).Fatalf("error creating NSE quotas: %+v", quotaErr)
+		}
+		authorizeNSEServer = chain.NewNetworkServiceEndpointRegistryServer(
+			authorizeNSEServer,
+			timeNSE("quota", quotaServer),
+		)
+	}
+	// Unregistrations are held down only once they have passed authorization, routing and the ownership check
+	if config.UnregisterHoldDown > 0 {
+		authorizeNSEServer = chain.NewNetworkServiceEndpointRegistryServer(
+			authorizeNSEServer,
+			timeNSE("holddown", holddown.NewNetworkServiceEndpointRegistryServer(ctx, config.Namespace, config.UnregisterHoldDown)),
+		)
+	}
+	registryServer := registryk8s.NewServer(
 		&config.Config,
 		spiffejwt.TokenGeneratorFunc(source, config.MaxTokenLifetime),
-		registryk8s.WithAuthorizeNSERegistryServer(authorize.NewNetworkServiceEndpointRegistryServer(authorize.WithPolicies(config.RegistryServerPolicies...))),
+		registryk8s.WithAuthorizeNSERegistryServer(authorizeNSEServer),
 		registryk8s.WithAuthorizeNSERegistryClient(authorize.NewNetworkServiceEndpointRegistryClient(authorize.WithPolicies(config.RegistryClientPolicies...))),
-		registryk8s.WithAuthorizeNSRegistryServer(authorize.NewNetworkServiceRegistryServer(authorize.WithPolicies(config.RegistryServerPolicies...))),
+		registryk8s.WithAuthorizeNSRegistryServer(timeNS("authorize", authorize.NewNetworkServiceRegistryServer(authorize.WithPolicies(config.RegistryServerPolicies...)))),
 		registryk8s.WithAuthorizeNSRegistryClient(authorize.NewNetworkServiceRegistryClient(authorize.WithPolicies(config.RegistryClientPolicies...))),
 		registryk8s.WithDialOptions(clientOptions...),
-	).Register(server)
+	)
+	counters := new(stats.Counters)
+	nseTracker := tracker.New()
+	var nseServers []registryapi.NetworkServiceEndpointRegistryServer
+	nsServer := timeNS("registryk8s", registryServer.NetworkServiceRegistryServer())
+	if config.ValidationEnabled {
+		nsServer = chain.NewNetworkServiceRegistryServer(timeNS("validation", validation.NewNetworkServiceRegistryServer(config.validationRules())), nsServer)
+	}
+	var limiter *ratelimit.Limiter
+	if config.rateLimited() {
+		limiter = ratelimit.NewLimiter(
+			ratelimit.Budget{QPS: float32(config.RateLimitRegisterQPS), Burst: config.RateLimitRegisterBurst},
+			ratelimit.Budget{QPS: float32(config.RateLimitUnregisterQPS), Burst: config.RateLimitUnregisterBurst},
+			ratelimit.Budget{QPS: float32(config.RateLimitFindQPS), Burst: config.RateLimitFindBurst},
+		)
+		nsServer = chain.NewNetworkServiceRegistryServer(ratelimit.NewNetworkServiceRegistryServer(limiter), nsServer)
+	}
+	if config.SlowRequestThreshold > 0 {
+		nseServers = append(nseServers, slowrequest.NewNetworkServiceEndpointRegistryServer(config.SlowRequestThreshold))
+		nsServer = chain.NewNetworkServiceRegistryServer(slowrequest.NewNetworkServiceRegistryServer(config.SlowRequestThreshold), nsServer)
+	}
+	nseServers = append(nseServers,
+		timeNSE("stats", stats.NewNetworkServiceEndpointRegistryServer(counters, config.Namespace)),
+		timeNSE("tracker", tracker.NewNetworkServiceEndpointRegistryServer(nseTracker)),
+	)
+	if config.FilterExpiredFromFind {
+		nseServers = append(nseServers, timeNSE("filterexpired", filterexpired.NewNetworkServiceEndpointRegistryServer()))
+	}
+	if config.ServerSideExpiration {
+		nseServers = append(nseServers, timeNSE("serverexpiration", serverexpiration.NewNetworkServiceEndpointRegistryServer(config.PeerTokenLifetime)))
+	}
+	// The element always runs to count registrations with expiration times in the past
+	var clampExpirationOptions []clampexpiration.Option
+	switch config.PastExpiration {
+	case pastExpirationReject:
+		clampExpirationOptions = append(clampExpirationOptions, clampexpiration.WithRejectPast())
+	case pastExpirationClamp:
+		clampExpirationOptions = append(clampExpirationOptions, clampexpiration.WithClampPast(config.ExpirePeriod))
+	}
+	nseServers = append(nseServers, timeNSE("clampexpiration",
+		clampexpiration.NewNetworkServiceEndpointRegistryServer(config.DefaultExpiration, config.MaxExpiration, clampExpirationOptions...)))
+	nseServers = append(nseServers, timeNSE("normalizeurl", normalizeurl.NewNetworkServiceEndpointRegistryServer()))
+	if config.ValidationEnabled {
+		nseServers = append(nseServers, timeNSE("validation", validation.NewNetworkServiceEndpointRegistryServer(config.validationRules())))
+	}
+	nseServers = append(nseServers, timeNSE("registryk8s", registryServer.NetworkServiceEndpointRegistryServer()))
+	// The liveness self Find bypasses the rate limiter, so throttled probes don't restart the registry
+	nseServer := chain.NewNetworkServiceEndpointRegistryServer(nseServers...)
+	livenessNSEServer := nseServer
+	if limiter != nil {
+		nseServer = chain.NewNetworkServiceEndpointRegistryServer(ratelimit.NewNetworkServiceEndpointRegistryServer(limiter), nseServer)
+	}
+	healthServer := health.Register(registryserver.NewServer(nsServer, nseServer), servers...)
+
+	heartbeatDone := make(chan struct{})
+	if config.EmitHeartbeatNSE {
+		name := config.HeartbeatNSEName
+		if name == "" {
+			hostname, _ := os.Hostname()
+			name = "registry-k8s-heartbeat-" + hostname
+		}
+		go func() {
+			defer close(heartbeatDone)
+			heartbeat.Run(ctx, client, config.Namespace, name, config.HeartbeatInterval)
+		}()
+	} else {
+		close(heartbeatDone)
+	}
+
+	if config.StatsLogInterval > 0 {
+		go statslog.Run(ctx, config.StatsLogInterval, counters, client, config.Namespace)
+	}
 
+	listenOptions := []grpclisten.Option{
+		grpclisten.WithMaxConnections(config.MaxConnections),
+		grpclisten.WithSocketMode(config.SocketFileMode),
+		grpclisten.WithSocketOwner(config.SocketUID, config.SocketGID),
+		grpclisten.WithSocketCleanup(config.SocketCleanup),
+	}
+	var listenersDone []<-chan struct{}
 	for i := 0; i < len(config.ListenOn); i++ {
-		srvErrCh := grpcutils.ListenAndServe(ctx, &config.ListenOn[i], server)
-		exitOnErr(ctx, cancel, srvErrCh)
+		listenServer := server
+		if listenSecurity(&config.ListenOn[i]) == listenSecurityPlaintext {
+			log.FromContext(ctx).Infof("Listening on %s without TLS", config.ListenOn[i].String())
+			listenServer = insecureServer
+		}
+		srvErrCh := grpclisten.ListenAndServe(ctx, &config.ListenOn[i], listenServer, listenOptions...)
+		listenersDone = append(listenersDone, exitOnErr(ctx, cancel, srvErrCh))
+	}
+	for i := 0; i < len(config.InsecureListenOn); i++ {
+		log.FromContext(ctx).Warnf("INSECURE: listening on %s without TLS, for development only", config.InsecureListenOn[i].String())
+		srvErrCh := grpclisten.ListenAndServe(ctx, &config.InsecureListenOn[i], insecureServer, listenOptions...)
+		listenersDone = append(listenersDone, exitOnErr(ctx, cancel, srvErrCh))
+	}
+	registryState := func() interface{} {
+		return struct {
+			tracker.Snapshot
+			ExpiryQueue expiryqueue.State `json:"expiryQueue"`
+		}{
+			Snapshot:    nseTracker.Snapshot(),
+			ExpiryQueue: expiryqueue.CurrentState(),
+		}
+	}
+	if config.DumpOnSignal {
+		dump.OnSignal(ctx, syscall.SIGUSR1, syscall.SIGUSR2, registryState)
+	}
+	if config.DebugListenOn != "" {
+		log.FromContext(ctx).Warnf("Debug endpoints are enabled on %s, they expose the registry state without authentication", config.DebugListenOn)
+		listenersDone = append(listenersDone, exitOnErr(ctx, cancel, debugserver.ListenAndServe(ctx, config.DebugListenOn, redactedConfig, registryState,
+			debugserver.WithTimeouts(config.AdminReadTimeout, config.AdminWriteTimeout, config.AdminIdleTimeout))))
+	}
+	if config.ProbeListenOn != "" {
+		probeOptions := []probe.Option{probe.WithTimeouts(config.AdminReadTimeout, config.AdminWriteTimeout, config.AdminIdleTimeout)}
+		if config.LivenessSelfFind {
+			probeOptions = append(probeOptions, probe.WithLivenessCheck(func(ctx context.Context) error {
+				return selfFind(ctx, livenessNSEServer)
+			}, config.LivenessSelfFindTimeout))
+		}
+		listenersDone = append(listenersDone, exitOnErr(ctx, cancel, probe.ListenAndServe(ctx, config.ProbeListenOn, healthServer, probeOptions...)))
+	}
+
+	// Subsystems are serving while the SVID is valid and their objects can be listed from the API server
+	healthCheck := func(resource string, list func(ctx context.Context) error) func(ctx context.Context) error {
+		return func(ctx context.Context) error {
+			svid, err := source.GetX509SVID()
+			if err != nil {
+				return errors.Wrap(err, "SVID is not available")
+			}
+			if expiresAt := svid.Certificates[0].NotAfter; time.Now().After(expiresAt) {
+				return errors.Errorf("SVID expired at %v", expiresAt)
+			}
+			checkCtx, cancel := context.WithTimeout(ctx, config.HealthCheckTimeout)
+			defer cancel()
+			// Missing permissions are checked again until they are granted
+			if config.RBACCheck == rbacCheckUnready {
+				if err = rbacChecker.Check(checkCtx, resource); err != nil {
+					return err
+				}
+			}
+			return errors.Wrap(list(checkCtx), "API server is not available")
+		}
 	}
+	go healthServer.Monitor(ctx, health.NetworkServiceEndpoints, config.HealthCheckInterval, healthCheck(rbaccheck.NetworkServiceEndpoints, func(ctx context.Context) error {
+		_, err := client.NetworkservicemeshV1().NetworkServiceEndpoints(config.Namespace).List(ctx, metav1.ListOptions{Limit: 1})
+		return err
+	}))
+	go healthServer.Monitor(ctx, health.NetworkServices, config.HealthCheckInterval, healthCheck(rbaccheck.NetworkServices, func(ctx context.Context) error {
+		_, err := client.NetworkservicemeshV1().NetworkServices(config.Namespace).List(ctx, metav1.ListOptions{Limit: 1})
+		return err
+	}))
 
-	log.FromContext(ctx).Infof("Startup completed in %v", time.Since(startTime))
+	startupDuration := time.Since(startTime)
+	log.FromContext(ctx).WithField("event", "ready").WithField("duration_seconds", startupDuration.Seconds()).Infof("Startup completed in %v", startupDuration)
 	<-ctx.Done()
+	healthServer.Shutdown()
+	waitListenersStopped(ctx, listenersDone, config.ListenersStopTimeout)
+	if config.HandoffEnabled {
+		releaseCtx, cancelRelease := context.WithTimeout(context.WithoutCancel(ctx), config.HandoffTimeout)
+		nseHandoff.Release(releaseCtx, nseTracker.Names())
+		cancelRelease()
+	}
+	<-heartbeatDone
+
+	uptime := time.Since(startTime)
+	reason := context.Cause(ctx)
+	log.FromContext(ctx).WithField("event", "shutdown").WithField("uptime_seconds", uptime.Seconds()).WithField("reason", reason.Error()).
+		Infof("Shutting down after %v: %v", uptime, reason)
+	traceShutdown(reason, uptime)
 }
 
-func exitOnErr(ctx context.Context, cancel context.CancelFunc, errCh <-chan error) {
+// newWorkloadAPISource waits up to timeout for the X.509 SVID from the Workload API at addr, or at
+// SPIFFE_ENDPOINT_SOCKET if addr is empty. If retry is set, it keeps waiting after each timeout until ctx is done.
+func newWorkloadAPISource(ctx context.Context, addr string, timeout time.Duration, retry bool) (*workloadapi.X509Source, error) {
+	var options []workloadapi.X509SourceOption
+	if addr != "" {
+		options = append(options, workloadapi.WithClientOptions(workloadapi.WithAddr(addr)))
+	}
+	for {
+		waitCtx, cancel := context.WithTimeout(ctx, timeout)
+		source, err := workloadapi.NewX509Source(waitCtx, options...)
+		cancel()
+		if err == nil || !retry || ctx.Err() != nil {
+			return source, errors.Wrapf(err, "no X.509 SVID received from the Workload API in %v", timeout)
+		}
+		log.FromContext(ctx).Errorf("No X.509 SVID received from the Workload API in %v, retrying: %v", timeout, err.Error())
+	}
+}
+
+// cipherSuites returns the IDs of the named cipher suites. Only the suites considered secure by crypto/tls are allowed.
+func cipherSuites(names []string) ([]uint16, error) {
+	var ids []uint16
+	for _, name := range names {
+		i := slices.IndexFunc(tls.CipherSuites(), func(suite *tls.CipherSuite) bool { return suite.Name == name })
+		if i < 0 {
+			return nil, errors.Errorf("unsupported TLS cipher suite %q", name)
+		}
+		ids = append(ids, tls.CipherSuites()[i].ID)
+	}
+	return ids, nil
+}
+
+// authorizeTrustDomains returns a TLS peer authorizer accepting SVIDs of the trust domains, or any SVID if there are
+// none. The trust domains must have been validated by Config.Validate.
+func authorizeTrustDomains(trustDomains []string) tlsconfig.Authorizer {
+	if len(trustDomains) == 0 {
+		return tlsconfig.AuthorizeAny()
+	}
+	var matchers []spiffeid.Matcher
+	for _, td := range trustDomains {
+		matchers = append(matchers, spiffeid.MatchMemberOf(spiffeid.RequireTrustDomainFromString(td)))
+	}
+	return tlsconfig.AdaptMatcher(func(id spiffeid.ID) error {
+		for _, match := range matchers {
+			if match(id) == nil {
+				return nil
+			}
+		}
+		return errors.Errorf("trust domain of %q is not allowed", id)
+	})
+}
+
+// k8sResourceAttributes returns the k8s.pod.name, k8s.node.name and k8s.namespace.name resource attributes from the
+// downward API env variables, skipping unset ones
+func k8sResourceAttributes() []attribute.KeyValue {
+	var attributes []attribute.KeyValue
+	for _, a := range []struct {
+		key attribute.Key
+		env string
+	}{
+		{key: semconv.K8SPodNameKey, env: "POD_NAME"},
+		{key: semconv.K8SNodeNameKey, env: "NODE_NAME"},
+		{key: semconv.K8SNamespaceNameKey, env: "POD_NAMESPACE"},
+	} {
+		if v := os.Getenv(a.env); v != "" {
+			attributes = append(attributes, a.key.String(v))
+		}
+	}
+	return attributes
+}
+
+// checkOtelExporters fails if the exporters can't be initialized or an empty export isn't accepted by the collector in
+// OtelRequiredTimeout. Metrics served to Prometheus are not pushed to the collector, so only spans are checked then.
+func checkOtelExporters(ctx context.Context, config *Config, spanExporter sdktrace.SpanExporter, metricExporter sdkmetric.Reader) {
+	if spanExporter == nil {
+		log.FromContext(ctx).Fatalf("OpenTelemetry is required, but the span exporter for %s can't be initialized", config.OpenTelemetryEndpoint)
+	}
+	if metricExporter == nil {
+		log.FromContext(ctx).Fatalf("OpenTelemetry is required, but the metric exporter can't be initialized")
+	}
+	checkCtx, cancel := context.WithTimeout(ctx, config.OtelRequiredTimeout)
+	defer cancel()
+	if err := otlpexport.CheckSpans(checkCtx, config.otlpConfig()); err != nil {
+		log.FromContext(ctx).Fatalf("OpenTelemetry is required, but the span exporter can't reach the collector: %v", err)
+	}
+	if prometheus.IsEnabled() {
+		return
+	}
+	if err := otlpexport.CheckMetrics(checkCtx, config.otlpConfig()); err != nil {
+		log.FromContext(ctx).Fatalf("OpenTelemetry is required, but the metric exporter can't reach the collector: %v", err)
+	}
+}
+
+// selfFind finds the NSE named after the liveness probe, which doesn't exist, through the NSE server
+func selfFind(ctx context.Context, server registryapi.NetworkServiceEndpointRegistryServer) error {
+	// Unexpected matches are dropped
+	ch := make(chan *registryapi.NetworkServiceEndpointResponse)
+	go func() {
+		for range ch {
+		}
+	}()
+	defer close(ch)
+	return server.Find(&registryapi.NetworkServiceEndpointQuery{
+		NetworkServiceEndpoint: &registryapi.NetworkServiceEndpoint{Name: livenessProbeNSEName},
+	}, streamchannel.NewNetworkServiceEndpointFindServer(ctx, ch))
+}
+
+// traceShutdown records the shutdown reason as a span event. It is a no-op if telemetry is disabled.
+func traceShutdown(reason error, uptime time.Duration) {
+	_, span := otel.Tracer("registry-k8s").Start(context.Background(), "shutdown")
+	span.AddEvent("shutdown", trace.WithAttributes(
+		attribute.String("reason", reason.Error()),
+		attribute.Float64("uptime_seconds", uptime.Seconds()),
+	))
+	span.End()
+}
+
+// waitListenersStopped waits until all listeners, which are stopped concurrently on ctx done, have stopped or the
+// timeout expires
+func waitListenersStopped(ctx context.Context, listenersDone []<-chan struct{}, timeout time.Duration) {
+	stopStartTime := time.Now()
+	deadline := time.After(timeout)
+	for _, done := range listenersDone {
+		select {
+		case <-done:
+		case <-deadline:
+			log.FromContext(ctx).Warnf("Listeners have not stopped in %v", timeout)
+			return
+		}
+	}
+	log.FromContext(ctx).Infof("Listeners stopped in %v", time.Since(stopStartTime))
+}
+
+// exitOnErr logs the listener errors and cancels ctx on them. It returns a channel which is closed when the listener
+// has stopped.
+func exitOnErr(ctx context.Context, cancel context.CancelCauseFunc, errCh <-chan error) <-chan struct{} {
 	// If we already have an error, log it and exit
 	select {
 	case err := <-errCh:
@@ -209,9 +1610,13 @@ func exitOnErr(ctx context.Context, cancel context.CancelFunc, errCh <-chan erro
 	default:
 	}
 	// Otherwise wait for an error in the background to log and cancel
+	done := make(chan struct{})
 	go func(ctx context.Context, errCh <-chan error) {
-		err := <-errCh
-		log.FromContext(ctx).Error(err)
-		cancel()
+		defer close(done)
+		for err := range errCh {
+			log.FromContext(ctx).Error(err)
+			cancel(err)
+		}
 	}(ctx, errCh)
+	return done
 }
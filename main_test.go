@@ -0,0 +1,266 @@
+// Copyright (c) 2026 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"math/big"
+	"net"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/kelseyhightower/envconfig"
+	"github.com/spiffe/go-spiffe/v2/bundle/x509bundle"
+	"github.com/spiffe/go-spiffe/v2/spiffeid"
+	"github.com/spiffe/go-spiffe/v2/svid/x509svid"
+)
+
+// newTestConfig returns the config with the default values
+func newTestConfig(t *testing.T) *Config {
+	t.Helper()
+	config := new(Config)
+	if err := envconfig.Process("registry_k8s_test", config); err != nil {
+		t.Fatal(err)
+	}
+	return config
+}
+
+func TestConfig_Validate_Defaults(t *testing.T) {
+	if err := newTestConfig(t).Validate(); err != nil {
+		t.Fatalf("default config is invalid: %v", err)
+	}
+}
+
+func TestConfig_Validate_ListenScheme(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		listenOn string
+		insecure bool
+		valid    bool
+	}{
+		{name: "unix", listenOn: "unix:///listen.on.socket", valid: true},
+		{name: "tcp", listenOn: "tcp://127.0.0.1:5002", valid: true},
+		{name: "http", listenOn: "http://127.0.0.1:5002"},
+		{name: "no scheme", listenOn: "/listen.on.socket"},
+		{name: "insecure http", listenOn: "http://127.0.0.1:5003", insecure: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			config := newTestConfig(t)
+			u, err := url.Parse(tc.listenOn)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tc.insecure {
+				config.InsecureListenOn = []url.URL{*u}
+				config.TLSMode = tlsModeSelfSigned
+			} else {
+				config.ListenOn = []url.URL{*u}
+			}
+
+			err = config.Validate()
+			switch {
+			case tc.valid && err != nil:
+				t.Fatalf("%s is rejected: %v", tc.listenOn, err)
+			case !tc.valid && err == nil:
+				t.Fatalf("%s is accepted", tc.listenOn)
+			case !tc.valid && !strings.Contains(err.Error(), "unsupported scheme"):
+				t.Fatalf("%s is rejected with an unexpected error: %v", tc.listenOn, err)
+			}
+		})
+	}
+}
+
+func TestConfig_Validate_PrometheusTLSMode(t *testing.T) {
+	t.Setenv("PROMETHEUS", "true")
+	for _, tc := range []struct {
+		tlsMode string
+		valid   bool
+	}{
+		{tlsMode: tlsModeSpire, valid: true},
+		{tlsMode: tlsModeSelfSigned},
+		{tlsMode: tlsModeFile},
+	} {
+		t.Run(tc.tlsMode, func(t *testing.T) {
+			config := newTestConfig(t)
+			config.TLSMode = tc.tlsMode
+			config.TLSCertFile, config.TLSKeyFile, config.TLSCAFile = "cert.pem", "key.pem", "ca.pem"
+
+			err := config.Validate()
+			switch {
+			case tc.valid && err != nil:
+				t.Fatalf("Prometheus is rejected in the %s TLS mode: %v", tc.tlsMode, err)
+			case !tc.valid && err == nil:
+				t.Fatalf("Prometheus is accepted in the %s TLS mode", tc.tlsMode)
+			case !tc.valid && !strings.Contains(err.Error(), "Prometheus"):
+				t.Fatalf("Prometheus is rejected in the %s TLS mode with an unexpected error: %v", tc.tlsMode, err)
+			}
+		})
+	}
+}
+
+var testSpiffeID = spiffeid.RequireFromString("spiffe://test.domain/registry")
+
+// newTestSVID returns a self-signed SVID valid until notAfter
+func newTestSVID(t *testing.T, notAfter time.Time) *x509svid.SVID {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          serial,
+		URIs:                  []*url.URL{testSpiffeID.URL()},
+		NotBefore:             time.Now().Add(-time.Minute),
+		NotAfter:              notAfter,
+		KeyUsage:              x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &x509svid.SVID{
+		ID:           testSpiffeID,
+		Certificates: []*x509.Certificate{cert},
+		PrivateKey:   key,
+	}
+}
+
+// rotatingSource is an x509Source whose SVID can be rotated. It trusts all SVIDs it has ever had.
+type rotatingSource struct {
+	mu          sync.Mutex
+	svid        *x509svid.SVID
+	authorities []*x509.Certificate
+}
+
+func (s *rotatingSource) rotate(svid *x509svid.SVID) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.svid = svid
+	s.authorities = append(s.authorities, svid.Certificates...)
+}
+
+func (s *rotatingSource) GetX509SVID() (*x509svid.SVID, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.svid, nil
+}
+
+func (s *rotatingSource) GetX509BundleForTrustDomain(trustDomain spiffeid.TrustDomain) (*x509bundle.Bundle, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return x509bundle.FromX509Authorities(trustDomain, s.authorities), nil
+}
+
+// handshake returns the certificate the server presents to the client on a new connection
+func handshake(t *testing.T, addr string, client *tls.Config) *x509.Certificate {
+	t.Helper()
+	conn, err := tls.Dial("tcp", addr, client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = conn.Close() }()
+	return conn.ConnectionState().PeerCertificates[0]
+}
+
+func TestConfig_TLSConfigs_Rotation(t *testing.T) {
+	source := new(rotatingSource)
+	first := newTestSVID(t, time.Now().Add(time.Hour))
+	source.rotate(first)
+
+	server, client := newTestConfig(t).tlsConfigs(source)
+	listener, err := tls.Listen("tcp", "127.0.0.1:0", server)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = listener.Close() }()
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			_ = conn.(*tls.Conn).Handshake()
+			_ = conn.Close()
+		}
+	}()
+	addr := listener.Addr().(*net.TCPAddr).String()
+
+	if cert := handshake(t, addr, client); !cert.Equal(first.Certificates[0]) {
+		t.Fatal("server presents an unknown certificate")
+	}
+
+	// The configs are not rebuilt, the next handshake picks up the rotated SVID
+	second := newTestSVID(t, time.Now().Add(2*time.Hour))
+	source.rotate(second)
+	if cert := handshake(t, addr, client); !cert.Equal(second.Certificates[0]) {
+		t.Fatal("server presents the certificate from before the rotation")
+	}
+}
+
+func TestCheckSVIDValidity(t *testing.T) {
+	minValidity := newTestConfig(t).MinSVIDValidity
+	for _, tc := range []struct {
+		name     string
+		notAfter time.Time
+		valid    bool
+	}{
+		{name: "long-lived", notAfter: time.Now().Add(time.Hour), valid: true},
+		{name: "short-lived", notAfter: time.Now().Add(minValidity / 2)},
+		{name: "expired", notAfter: time.Now().Add(-time.Second)},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := checkSVIDValidity(newTestSVID(t, tc.notAfter), minValidity)
+			if tc.valid && err != nil {
+				t.Fatalf("SVID is rejected: %v", err)
+			}
+			if !tc.valid && err == nil {
+				t.Fatal("SVID is accepted")
+			}
+		})
+	}
+}
+
+func TestK8sResourceAttributes(t *testing.T) {
+	t.Setenv("POD_NAME", "registry-k8s-0")
+	t.Setenv("NODE_NAME", "")
+	t.Setenv("POD_NAMESPACE", "nsm-system")
+
+	var got []string
+	for _, a := range k8sResourceAttributes() {
+		got = append(got, string(a.Key)+"="+a.Value.AsString())
+	}
+	if want := "k8s.pod.name=registry-k8s-0,k8s.namespace.name=nsm-system"; strings.Join(got, ",") != want {
+		t.Fatalf("resource attributes %v, want %s", got, want)
+	}
+}
@@ -2,31 +2,133 @@
 package imports
 
 import (
+	_ "bufio"
+	_ "bytes"
+	_ "container/heap"
 	_ "context"
+	_ "crypto/ecdsa"
+	_ "crypto/elliptic"
+	_ "crypto/rand"
 	_ "crypto/tls"
+	_ "crypto/x509"
+	_ "crypto/x509/pkix"
+	_ "encoding/json"
+	_ "expvar"
+	_ "fmt"
 	_ "github.com/antonfisher/nested-logrus-formatter"
 	_ "github.com/edwarnicke/grpcfd"
+	_ "github.com/fsnotify/fsnotify"
+	_ "github.com/golang-jwt/jwt/v4"
+	_ "github.com/golang/protobuf/ptypes/empty"
 	_ "github.com/kelseyhightower/envconfig"
+	_ "github.com/networkservicemesh/api/pkg/api/registry"
 	_ "github.com/networkservicemesh/sdk-k8s/pkg/registry/chains/registryk8s"
 	_ "github.com/networkservicemesh/sdk-k8s/pkg/tools/k8s"
+	_ "github.com/networkservicemesh/sdk-k8s/pkg/tools/k8s/apis/networkservicemesh.io/v1"
+	_ "github.com/networkservicemesh/sdk-k8s/pkg/tools/k8s/client/clientset/versioned"
+	_ "github.com/networkservicemesh/sdk-k8s/pkg/tools/k8s/client/clientset/versioned/fake"
+	_ "github.com/networkservicemesh/sdk-k8s/pkg/tools/k8s/client/clientset/versioned/typed/networkservicemesh.io/v1"
+	_ "github.com/networkservicemesh/sdk-k8s/pkg/tools/k8s/client/informers/externalversions"
+	_ "github.com/networkservicemesh/sdk-k8s/pkg/tools/k8s/client/informers/externalversions/networkservicemesh.io/v1"
+	_ "github.com/networkservicemesh/sdk-k8s/pkg/tools/k8s/client/listers/networkservicemesh.io/v1"
+	_ "github.com/networkservicemesh/sdk/pkg/registry"
 	_ "github.com/networkservicemesh/sdk/pkg/registry/common/authorize"
+	_ "github.com/networkservicemesh/sdk/pkg/registry/common/grpcmetadata"
+	_ "github.com/networkservicemesh/sdk/pkg/registry/core/chain"
+	_ "github.com/networkservicemesh/sdk/pkg/registry/core/next"
+	_ "github.com/networkservicemesh/sdk/pkg/registry/core/streamchannel"
+	_ "github.com/networkservicemesh/sdk/pkg/registry/core/streamcontext"
+	_ "github.com/networkservicemesh/sdk/pkg/tools/clock"
+	_ "github.com/networkservicemesh/sdk/pkg/tools/clockmock"
 	_ "github.com/networkservicemesh/sdk/pkg/tools/debug"
 	_ "github.com/networkservicemesh/sdk/pkg/tools/grpcutils"
 	_ "github.com/networkservicemesh/sdk/pkg/tools/log"
 	_ "github.com/networkservicemesh/sdk/pkg/tools/log/logruslogger"
 	_ "github.com/networkservicemesh/sdk/pkg/tools/opentelemetry"
 	_ "github.com/networkservicemesh/sdk/pkg/tools/pprofutils"
+	_ "github.com/networkservicemesh/sdk/pkg/tools/prometheus"
 	_ "github.com/networkservicemesh/sdk/pkg/tools/spiffejwt"
 	_ "github.com/networkservicemesh/sdk/pkg/tools/token"
 	_ "github.com/networkservicemesh/sdk/pkg/tools/tracing"
+	_ "github.com/pkg/errors"
 	_ "github.com/sirupsen/logrus"
+	_ "github.com/spiffe/go-spiffe/v2/bundle/x509bundle"
+	_ "github.com/spiffe/go-spiffe/v2/spiffeid"
 	_ "github.com/spiffe/go-spiffe/v2/spiffetls/tlsconfig"
+	_ "github.com/spiffe/go-spiffe/v2/svid/x509svid"
 	_ "github.com/spiffe/go-spiffe/v2/workloadapi"
+	_ "go.opentelemetry.io/otel"
+	_ "go.opentelemetry.io/otel/attribute"
+	_ "go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricgrpc"
+	_ "go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetrichttp"
+	_ "go.opentelemetry.io/otel/exporters/otlp/otlptrace"
+	_ "go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	_ "go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	_ "go.opentelemetry.io/otel/metric"
+	_ "go.opentelemetry.io/otel/propagation"
+	_ "go.opentelemetry.io/otel/sdk/metric"
+	_ "go.opentelemetry.io/otel/sdk/metric/metricdata"
+	_ "go.opentelemetry.io/otel/sdk/resource"
+	_ "go.opentelemetry.io/otel/sdk/trace"
+	_ "go.opentelemetry.io/otel/semconv/v1.4.0"
+	_ "go.opentelemetry.io/otel/trace"
+	_ "golang.org/x/net/netutil"
 	_ "google.golang.org/grpc"
+	_ "google.golang.org/grpc/backoff"
+	_ "google.golang.org/grpc/codes"
 	_ "google.golang.org/grpc/credentials"
+	_ "google.golang.org/grpc/encoding/gzip"
+	_ "google.golang.org/grpc/health"
+	_ "google.golang.org/grpc/health/grpc_health_v1"
+	_ "google.golang.org/grpc/keepalive"
+	_ "google.golang.org/grpc/metadata"
+	_ "google.golang.org/grpc/peer"
+	_ "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/proto"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	_ "hash/fnv"
+	_ "io"
+	_ "k8s.io/api/authorization/v1"
+	_ "k8s.io/api/coordination/v1"
+	_ "k8s.io/apimachinery/pkg/api/errors"
+	_ "k8s.io/apimachinery/pkg/apis/meta/v1"
+	_ "k8s.io/apimachinery/pkg/labels"
+	_ "k8s.io/apimachinery/pkg/runtime"
+	_ "k8s.io/apimachinery/pkg/runtime/schema"
+	_ "k8s.io/apimachinery/pkg/types"
+	_ "k8s.io/apimachinery/pkg/util/validation"
+	_ "k8s.io/apimachinery/pkg/watch"
+	_ "k8s.io/client-go/kubernetes"
+	_ "k8s.io/client-go/kubernetes/fake"
+	_ "k8s.io/client-go/kubernetes/typed/coordination/v1"
+	_ "k8s.io/client-go/rest"
+	_ "k8s.io/client-go/testing"
+	_ "k8s.io/client-go/tools/cache"
+	_ "k8s.io/client-go/tools/clientcmd"
+	_ "k8s.io/client-go/tools/leaderelection"
+	_ "k8s.io/client-go/tools/leaderelection/resourcelock"
+	_ "k8s.io/client-go/tools/metrics"
+	_ "k8s.io/client-go/util/flowcontrol"
+	_ "k8s.io/client-go/util/workqueue"
+	_ "math/big"
+	_ "math/rand"
+	_ "net"
+	_ "net/http"
+	_ "net/http/httptest"
+	_ "net/http/pprof"
 	_ "net/url"
 	_ "os"
 	_ "os/signal"
+	_ "path/filepath"
+	_ "reflect"
+	_ "runtime/pprof"
+	_ "slices"
+	_ "sort"
+	_ "strconv"
+	_ "strings"
+	_ "sync"
+	_ "sync/atomic"
 	_ "syscall"
+	_ "testing"
 	_ "time"
 )
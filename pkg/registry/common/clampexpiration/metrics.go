@@ -0,0 +1,41 @@
+// Copyright (c) 2026 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clampexpiration
+
+import (
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/metric"
+)
+
+const (
+	meterName                      = "github.com/networkservicemesh/cmd-registry-k8s/pkg/registry/common/clampexpiration"
+	clockSkewDetectionsCounterName = "registry_k8s_clock_skew_detections_total"
+)
+
+// clockSkewDetections counts NSE registrations with expiration times in the past
+var clockSkewDetections metric.Int64Counter
+
+func init() {
+	var err error
+	clockSkewDetections, err = otel.Meter(meterName).Int64Counter(
+		clockSkewDetectionsCounterName,
+		metric.WithDescription("Number of NSE registrations with expiration times in the past, e.g. because of client clock skew"),
+	)
+	if err != nil {
+		otel.Handle(err)
+	}
+}
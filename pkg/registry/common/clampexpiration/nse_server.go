@@ -0,0 +1,109 @@
+// Copyright (c) 2026 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clampexpiration
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/networkservicemesh/api/pkg/api/registry"
+
+	"github.com/networkservicemesh/sdk/pkg/registry/core/next"
+	"github.com/networkservicemesh/sdk/pkg/tools/clock"
+	"github.com/networkservicemesh/sdk/pkg/tools/log"
+)
+
+type clampExpirationNSEServer struct {
+	defaultExpiration time.Duration
+	maxExpiration     time.Duration
+	*options
+}
+
+// NewNetworkServiceEndpointRegistryServer creates a new chain element setting the expiration time of registered NSEs
+// without one to now + defaultExpiration and moving expiration times later than now + maxExpiration to it. Zero
+// durations disable the corresponding rule. Expiration times in the past, usually sent by clients with skewed clocks,
+// are counted and accepted unless WithRejectPast or WithClampPast is set.
+func NewNetworkServiceEndpointRegistryServer(defaultExpiration, maxExpiration time.Duration, opts ...Option) registry.NetworkServiceEndpointRegistryServer {
+	o := new(options)
+	for _, opt := range opts {
+		opt(o)
+	}
+	return &clampExpirationNSEServer{
+		defaultExpiration: defaultExpiration,
+		maxExpiration:     maxExpiration,
+		options:           o,
+	}
+}
+
+func (s *clampExpirationNSEServer) Register(ctx context.Context, nse *registry.NetworkServiceEndpoint) (*registry.NetworkServiceEndpoint, error) {
+	now := clock.FromContext(ctx).Now()
+	switch {
+	case nse.GetExpirationTime() != nil && !nse.GetExpirationTime().AsTime().After(now):
+		if err := s.past(ctx, nse, now); err != nil {
+			return nil, err
+		}
+	case nse.GetExpirationTime() == nil:
+		if s.defaultExpiration > 0 {
+			nse.ExpirationTime = timestamppb.New(now.Add(s.defaultExpiration))
+		}
+	case s.maxExpiration > 0 && nse.GetExpirationTime().AsTime().After(now.Add(s.maxExpiration)):
+		log.FromContext(ctx).WithField("clampExpirationNSEServer", "Register").
+			Debugf("clamped expiration time %v of %s to %v", nse.GetExpirationTime().AsTime(), nse.GetName(), s.maxExpiration)
+		nse.ExpirationTime = timestamppb.New(now.Add(s.maxExpiration))
+	}
+	return next.NetworkServiceEndpointRegistryServer(ctx).Register(ctx, nse)
+}
+
+// past handles the expiration time of the NSE which is not after now
+func (s *clampExpirationNSEServer) past(ctx context.Context, nse *registry.NetworkServiceEndpoint, now time.Time) error {
+	skew := now.Sub(nse.GetExpirationTime().AsTime())
+	switch {
+	case s.rejectPast:
+		detected(ctx, nse, skew, "rejected")
+		return status.Errorf(codes.InvalidArgument, "expiration time %v of NSE %s is %v in the past", nse.GetExpirationTime().AsTime(), nse.GetName(), skew)
+	case s.pastPeriod > 0:
+		detected(ctx, nse, skew, "clamped")
+		nse.ExpirationTime = timestamppb.New(now.Add(s.pastPeriod))
+	default:
+		detected(ctx, nse, skew, "accepted")
+	}
+	return nil
+}
+
+// detected counts and logs the clock skew of the client registering the NSE
+func detected(ctx context.Context, nse *registry.NetworkServiceEndpoint, skew time.Duration, action string) {
+	if clockSkewDetections != nil {
+		clockSkewDetections.Add(ctx, 1, metric.WithAttributes(attribute.String("action", action)))
+	}
+	log.FromContext(ctx).WithField("clampExpirationNSEServer", "Register").WithField("nse_name", nse.GetName()).
+		Warnf("expiration time of %s is %v in the past, the client clock may be skewed, %s", nse.GetName(), skew, action)
+}
+
+func (s *clampExpirationNSEServer) Find(query *registry.NetworkServiceEndpointQuery, server registry.NetworkServiceEndpointRegistry_FindServer) error {
+	return next.NetworkServiceEndpointRegistryServer(server.Context()).Find(query, server)
+}
+
+func (s *clampExpirationNSEServer) Unregister(ctx context.Context, nse *registry.NetworkServiceEndpoint) (*empty.Empty, error) {
+	return next.NetworkServiceEndpointRegistryServer(ctx).Unregister(ctx, nse)
+}
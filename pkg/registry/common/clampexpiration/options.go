@@ -0,0 +1,43 @@
+// Copyright (c) 2026 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clampexpiration
+
+import (
+	"time"
+)
+
+type options struct {
+	rejectPast bool
+	pastPeriod time.Duration
+}
+
+// Option is an option pattern for NewNetworkServiceEndpointRegistryServer
+type Option func(o *options)
+
+// WithRejectPast makes the element reject registrations with expiration times in the past with InvalidArgument
+func WithRejectPast() Option {
+	return func(o *options) {
+		o.rejectPast = true
+	}
+}
+
+// WithClampPast makes the element move expiration times in the past to now + period, e.g. the refresh interval of NSEs
+func WithClampPast(period time.Duration) Option {
+	return func(o *options) {
+		o.pastPeriod = period
+	}
+}
@@ -0,0 +1,62 @@
+// Copyright (c) 2026 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filterexpired
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/ptypes/empty"
+
+	"github.com/networkservicemesh/api/pkg/api/registry"
+
+	"github.com/networkservicemesh/sdk/pkg/registry/core/next"
+	"github.com/networkservicemesh/sdk/pkg/tools/clock"
+)
+
+type filterExpiredNSEServer struct{}
+
+// NewNetworkServiceEndpointRegistryServer creates a new chain element dropping NSEs with past expiration times from
+// Find responses, so clients don't see NSEs which are expired but not deleted yet. Deletion events are always sent.
+func NewNetworkServiceEndpointRegistryServer() registry.NetworkServiceEndpointRegistryServer {
+	return new(filterExpiredNSEServer)
+}
+
+func (s *filterExpiredNSEServer) Register(ctx context.Context, nse *registry.NetworkServiceEndpoint) (*registry.NetworkServiceEndpoint, error) {
+	return next.NetworkServiceEndpointRegistryServer(ctx).Register(ctx, nse)
+}
+
+func (s *filterExpiredNSEServer) Find(query *registry.NetworkServiceEndpointQuery, server registry.NetworkServiceEndpointRegistry_FindServer) error {
+	return next.NetworkServiceEndpointRegistryServer(server.Context()).Find(query, &filterExpiredFindServer{
+		NetworkServiceEndpointRegistry_FindServer: server,
+	})
+}
+
+func (s *filterExpiredNSEServer) Unregister(ctx context.Context, nse *registry.NetworkServiceEndpoint) (*empty.Empty, error) {
+	return next.NetworkServiceEndpointRegistryServer(ctx).Unregister(ctx, nse)
+}
+
+type filterExpiredFindServer struct {
+	registry.NetworkServiceEndpointRegistry_FindServer
+}
+
+func (s *filterExpiredFindServer) Send(resp *registry.NetworkServiceEndpointResponse) error {
+	expirationTime := resp.GetNetworkServiceEndpoint().GetExpirationTime()
+	if !resp.GetDeleted() && expirationTime != nil && expirationTime.AsTime().Before(clock.FromContext(s.Context()).Now()) {
+		return nil
+	}
+	return s.NetworkServiceEndpointRegistry_FindServer.Send(resp)
+}
@@ -0,0 +1,103 @@
+// Copyright (c) 2026 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filterexpired_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/networkservicemesh/api/pkg/api/registry"
+
+	"github.com/networkservicemesh/sdk/pkg/registry/core/next"
+	"github.com/networkservicemesh/sdk/pkg/registry/core/streamchannel"
+	"github.com/networkservicemesh/sdk/pkg/tools/clock"
+	"github.com/networkservicemesh/sdk/pkg/tools/clockmock"
+
+	"github.com/networkservicemesh/cmd-registry-k8s/pkg/registry/common/filterexpired"
+)
+
+type sendNSEServer struct {
+	resps []*registry.NetworkServiceEndpointResponse
+}
+
+func (s *sendNSEServer) Register(ctx context.Context, nse *registry.NetworkServiceEndpoint) (*registry.NetworkServiceEndpoint, error) {
+	return nse, nil
+}
+
+func (s *sendNSEServer) Find(_ *registry.NetworkServiceEndpointQuery, server registry.NetworkServiceEndpointRegistry_FindServer) error {
+	for _, resp := range s.resps {
+		if err := server.Send(resp); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *sendNSEServer) Unregister(context.Context, *registry.NetworkServiceEndpoint) (*empty.Empty, error) {
+	return new(empty.Empty), nil
+}
+
+func TestFilterExpiredNSEServer_Find(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	clockMock := clockmock.New(ctx)
+	ctx = clock.WithClock(ctx, clockMock)
+
+	nse := func(name string, expirationTime *timestamppb.Timestamp) *registry.NetworkServiceEndpoint {
+		return &registry.NetworkServiceEndpoint{Name: name, ExpirationTime: expirationTime}
+	}
+	past := timestamppb.New(clockMock.Now().Add(-time.Second))
+	future := timestamppb.New(clockMock.Now().Add(time.Minute))
+
+	server := next.NewNetworkServiceEndpointRegistryServer(
+		filterexpired.NewNetworkServiceEndpointRegistryServer(),
+		&sendNSEServer{resps: []*registry.NetworkServiceEndpointResponse{
+			{NetworkServiceEndpoint: nse("live", future)},
+			{NetworkServiceEndpoint: nse("expired", past)},
+			{NetworkServiceEndpoint: nse("no-expiration", nil)},
+			{NetworkServiceEndpoint: nse("expired-deleted", past), Deleted: true},
+			{NetworkServiceEndpoint: nse("live-deleted", future), Deleted: true},
+		}},
+	)
+
+	ch := make(chan *registry.NetworkServiceEndpointResponse, 10)
+	if err := server.Find(&registry.NetworkServiceEndpointQuery{
+		NetworkServiceEndpoint: new(registry.NetworkServiceEndpoint),
+	}, streamchannel.NewNetworkServiceEndpointFindServer(ctx, ch)); err != nil {
+		t.Fatal(err)
+	}
+	close(ch)
+
+	var names []string
+	for resp := range ch {
+		names = append(names, resp.GetNetworkServiceEndpoint().GetName())
+	}
+	want := []string{"live", "no-expiration", "expired-deleted", "live-deleted"}
+	if len(names) != len(want) {
+		t.Fatalf("Find sent %v, want %v", names, want)
+	}
+	for i := range want {
+		if names[i] != want[i] {
+			t.Fatalf("Find sent %v, want %v", names, want)
+		}
+	}
+}
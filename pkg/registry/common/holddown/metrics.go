@@ -0,0 +1,54 @@
+// Copyright (c) 2026 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package holddown
+
+import (
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/metric"
+)
+
+const (
+	meterName                        = "github.com/networkservicemesh/cmd-registry-k8s/pkg/registry/common/holddown"
+	cancelledUnregistersCounterName  = "registry_k8s_held_down_unregisters_cancelled_total"
+	propagatedUnregistersCounterName = "registry_k8s_held_down_unregisters_propagated_total"
+)
+
+var (
+	// cancelledUnregisters counts held down unregistrations cancelled by a registration of the same NSE
+	cancelledUnregisters metric.Int64Counter
+	// propagatedUnregisters counts held down unregistrations propagated after the hold-down window or on shutdown
+	propagatedUnregisters metric.Int64Counter
+)
+
+func init() {
+	var err error
+	meter := otel.Meter(meterName)
+	cancelledUnregisters, err = meter.Int64Counter(
+		cancelledUnregistersCounterName,
+		metric.WithDescription("Number of held down NSE unregistrations cancelled by a registration of the same NSE"),
+	)
+	if err != nil {
+		otel.Handle(err)
+	}
+	propagatedUnregisters, err = meter.Int64Counter(
+		propagatedUnregistersCounterName,
+		metric.WithDescription("Number of held down NSE unregistrations propagated after the hold-down window or on shutdown"),
+	)
+	if err != nil {
+		otel.Handle(err)
+	}
+}
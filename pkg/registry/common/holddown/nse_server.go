@@ -0,0 +1,163 @@
+// Copyright (c) 2026 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package holddown
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/empty"
+
+	"github.com/networkservicemesh/api/pkg/api/registry"
+
+	"github.com/networkservicemesh/sdk/pkg/registry/core/next"
+	"github.com/networkservicemesh/sdk/pkg/tools/clock"
+	"github.com/networkservicemesh/sdk/pkg/tools/log"
+
+	"github.com/networkservicemesh/cmd-registry-k8s/pkg/tools/clientset/multinamespace"
+)
+
+// unregistration is a held down unregistration. It is propagated by whoever removes it from the pending ones.
+type unregistration struct {
+	ctx     context.Context
+	timeout time.Duration
+	nse     *registry.NetworkServiceEndpoint
+	timer   clock.Timer
+}
+
+type holdDownNSEServer struct {
+	namespace string
+	window    time.Duration
+
+	mu      sync.Mutex
+	pending map[string]*unregistration
+	closed  bool
+}
+
+// NewNetworkServiceEndpointRegistryServer creates a new chain element holding NSE unregistrations down for window.
+// Unregister succeeds immediately and is propagated to the next elements after the window, unless the same NSE is
+// registered again within it, which cancels the unregistration. Until then, Find keeps returning the NSE. NSEs are
+// identified by the name and the namespace routed by namespacerouting, or namespace, so the element must follow
+// authorization and routing. When ctx is done, pending unregistrations are propagated at once and new ones are not
+// held down anymore.
+func NewNetworkServiceEndpointRegistryServer(ctx context.Context, namespace string, window time.Duration) registry.NetworkServiceEndpointRegistryServer {
+	s := &holdDownNSEServer{
+		namespace: namespace,
+		window:    window,
+		pending:   make(map[string]*unregistration),
+	}
+	context.AfterFunc(ctx, s.flush)
+	return s
+}
+
+func (s *holdDownNSEServer) Register(ctx context.Context, nse *registry.NetworkServiceEndpoint) (*registry.NetworkServiceEndpoint, error) {
+	if u := s.take(s.key(ctx, nse.GetName()), nil); u != nil {
+		u.timer.Stop()
+		log.FromContext(ctx).WithField("holdDownNSEServer", "Register").Debugf("cancelled the unregistration of %s", nse.GetName())
+		if cancelledUnregisters != nil {
+			cancelledUnregisters.Add(ctx, 1)
+		}
+	}
+	return next.NetworkServiceEndpointRegistryServer(ctx).Register(ctx, nse)
+}
+
+func (s *holdDownNSEServer) Find(query *registry.NetworkServiceEndpointQuery, server registry.NetworkServiceEndpointRegistry_FindServer) error {
+	return next.NetworkServiceEndpointRegistryServer(server.Context()).Find(query, server)
+}
+
+func (s *holdDownNSEServer) Unregister(ctx context.Context, nse *registry.NetworkServiceEndpoint) (*empty.Empty, error) {
+	key := s.key(ctx, nse.GetName())
+	// The unregistration outlives the request, so it keeps the values and the timeout of the request context but
+	// not its cancellation
+	u := &unregistration{
+		ctx: context.WithoutCancel(ctx),
+		nse: nse,
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		u.timeout = clock.FromContext(ctx).Until(deadline)
+	}
+
+	s.mu.Lock()
+	if s.closed {
+		s.mu.Unlock()
+		return next.NetworkServiceEndpointRegistryServer(ctx).Unregister(ctx, nse)
+	}
+	defer s.mu.Unlock()
+	if previous, ok := s.pending[key]; ok {
+		previous.timer.Stop()
+	}
+	u.timer = clock.FromContext(ctx).AfterFunc(s.window, func() {
+		if s.take(key, u) != nil {
+			s.propagate(u)
+		}
+	})
+	s.pending[key] = u
+	return new(empty.Empty), nil
+}
+
+// key returns the key of the pending unregistration of the NSE with the name
+func (s *holdDownNSEServer) key(ctx context.Context, name string) string {
+	namespace := multinamespace.NamespaceFromContext(ctx)
+	if namespace == "" {
+		namespace = s.namespace
+	}
+	return namespace + "/" + name
+}
+
+// take removes the pending unregistration with the key and returns it. If expected is not nil, the unregistration is
+// removed only if it is still the expected one.
+func (s *holdDownNSEServer) take(key string, expected *unregistration) *unregistration {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	u, ok := s.pending[key]
+	if !ok || expected != nil && u != expected {
+		return nil
+	}
+	delete(s.pending, key)
+	return u
+}
+
+// flush propagates all pending unregistrations and stops holding new ones down
+func (s *holdDownNSEServer) flush() {
+	s.mu.Lock()
+	pending := s.pending
+	s.pending = make(map[string]*unregistration)
+	s.closed = true
+	s.mu.Unlock()
+
+	for _, u := range pending {
+		u.timer.Stop()
+		s.propagate(u)
+	}
+}
+
+func (s *holdDownNSEServer) propagate(u *unregistration) {
+	ctx := u.ctx
+	if u.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, u.timeout)
+		defer cancel()
+	}
+	if propagatedUnregisters != nil {
+		propagatedUnregisters.Add(ctx, 1)
+	}
+	if _, err := next.NetworkServiceEndpointRegistryServer(ctx).Unregister(ctx, u.nse); err != nil {
+		log.FromContext(ctx).WithField("holdDownNSEServer", "Unregister").WithField("nse_name", u.nse.GetName()).
+			Warnf("failed to unregister %s after the hold-down: %v", u.nse.GetName(), err.Error())
+	}
+}
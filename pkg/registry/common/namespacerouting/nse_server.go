@@ -0,0 +1,68 @@
+// Copyright (c) 2026 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package namespacerouting
+
+import (
+	"context"
+	"slices"
+
+	"github.com/golang/protobuf/ptypes/empty"
+
+	"github.com/networkservicemesh/api/pkg/api/registry"
+
+	"github.com/networkservicemesh/sdk/pkg/registry/core/next"
+
+	"github.com/networkservicemesh/cmd-registry-k8s/pkg/tools/caller"
+	"github.com/networkservicemesh/cmd-registry-k8s/pkg/tools/clientset/multinamespace"
+)
+
+type namespaceRoutingNSEServer struct {
+	namespaces []string
+}
+
+// NewNetworkServiceEndpointRegistryServer creates a new chain element routing the NSE writes of Register and Unregister
+// to the namespace of the caller SPIFFE ID in the spiffe://<trust domain>/ns/<namespace>/... form with
+// multinamespace.WithNamespace. Callers without a namespace or with a namespace not in namespaces keep the default
+// namespace of the registry; nil namespaces allows all namespaces. The element must follow the path update and
+// authorization.
+func NewNetworkServiceEndpointRegistryServer(namespaces []string) registry.NetworkServiceEndpointRegistryServer {
+	return &namespaceRoutingNSEServer{
+		namespaces: namespaces,
+	}
+}
+
+func (s *namespaceRoutingNSEServer) Register(ctx context.Context, nse *registry.NetworkServiceEndpoint) (*registry.NetworkServiceEndpoint, error) {
+	ctx = s.route(ctx)
+	return next.NetworkServiceEndpointRegistryServer(ctx).Register(ctx, nse)
+}
+
+func (s *namespaceRoutingNSEServer) Find(query *registry.NetworkServiceEndpointQuery, server registry.NetworkServiceEndpointRegistry_FindServer) error {
+	return next.NetworkServiceEndpointRegistryServer(server.Context()).Find(query, server)
+}
+
+func (s *namespaceRoutingNSEServer) Unregister(ctx context.Context, nse *registry.NetworkServiceEndpoint) (*empty.Empty, error) {
+	ctx = s.route(ctx)
+	return next.NetworkServiceEndpointRegistryServer(ctx).Unregister(ctx, nse)
+}
+
+func (s *namespaceRoutingNSEServer) route(ctx context.Context) context.Context {
+	namespace := caller.Namespace(caller.FromContext(ctx))
+	if namespace == "" || (s.namespaces != nil && !slices.Contains(s.namespaces, namespace)) {
+		return ctx
+	}
+	return multinamespace.WithNamespace(ctx, namespace)
+}
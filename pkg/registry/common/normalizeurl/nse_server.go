@@ -0,0 +1,64 @@
+// Copyright (c) 2026 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package normalizeurl
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/networkservicemesh/api/pkg/api/registry"
+
+	"github.com/networkservicemesh/sdk/pkg/registry/core/next"
+	"github.com/networkservicemesh/sdk/pkg/tools/log"
+
+	"github.com/networkservicemesh/cmd-registry-k8s/pkg/tools/ipurl"
+)
+
+type normalizeURLNSEServer struct{}
+
+// NewNetworkServiceEndpointRegistryServer creates a new chain element rejecting registrations of NSEs with URLs with
+// IPv6 literals without brackets and rewriting IPv6 literals to their canonical form, so NSEs are dialed and compared
+// by the same URL over IPv6
+func NewNetworkServiceEndpointRegistryServer() registry.NetworkServiceEndpointRegistryServer {
+	return new(normalizeURLNSEServer)
+}
+
+func (s *normalizeURLNSEServer) Register(ctx context.Context, nse *registry.NetworkServiceEndpoint) (*registry.NetworkServiceEndpoint, error) {
+	if nse.GetUrl() != "" {
+		normalized, err := ipurl.NormalizeString(nse.GetUrl())
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid URL of NSE %s: %v", nse.GetName(), err.Error())
+		}
+		if normalized != nse.GetUrl() {
+			log.FromContext(ctx).WithField("normalizeURLNSEServer", "Register").
+				Debugf("normalized URL %s of %s to %s", nse.GetUrl(), nse.GetName(), normalized)
+			nse.Url = normalized
+		}
+	}
+	return next.NetworkServiceEndpointRegistryServer(ctx).Register(ctx, nse)
+}
+
+func (s *normalizeURLNSEServer) Find(query *registry.NetworkServiceEndpointQuery, server registry.NetworkServiceEndpointRegistry_FindServer) error {
+	return next.NetworkServiceEndpointRegistryServer(server.Context()).Find(query, server)
+}
+
+func (s *normalizeURLNSEServer) Unregister(ctx context.Context, nse *registry.NetworkServiceEndpoint) (*empty.Empty, error) {
+	return next.NetworkServiceEndpointRegistryServer(ctx).Unregister(ctx, nse)
+}
@@ -0,0 +1,108 @@
+// Copyright (c) 2026 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ownership
+
+import (
+	"context"
+	"slices"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/networkservicemesh/api/pkg/api/registry"
+
+	"github.com/networkservicemesh/sdk/pkg/registry/core/next"
+
+	"github.com/networkservicemesh/sdk-k8s/pkg/tools/k8s/client/clientset/versioned"
+
+	"github.com/networkservicemesh/cmd-registry-k8s/pkg/tools/caller"
+	"github.com/networkservicemesh/cmd-registry-k8s/pkg/tools/clientset/registeredby"
+)
+
+// Annotation is the NSE annotation with the SPIFFE ID that registered the NSE
+const Annotation = registeredby.Annotation
+
+type ownershipNSEServer struct {
+	client    versioned.Interface
+	namespace string
+	admins    []string
+}
+
+// NewNetworkServiceEndpointRegistryServer creates a new chain element rejecting refreshes and unregisters of NSEs
+// in the namespace owned by another SPIFFE ID, unless the caller is one of admins. The caller is the SPIFFE ID of the
+// first path segment, so the element must follow the path update and authorization. The owner is written by
+// the registeredby clientset, which must wrap the client of the etcd server. If the client is a multinamespace
+// clientset, the owners are looked up in the namespace routed by namespacerouting instead of the namespace.
+func NewNetworkServiceEndpointRegistryServer(client versioned.Interface, namespace string, admins ...string) registry.NetworkServiceEndpointRegistryServer {
+	return &ownershipNSEServer{
+		client:    client,
+		namespace: namespace,
+		admins:    admins,
+	}
+}
+
+func (s *ownershipNSEServer) Register(ctx context.Context, nse *registry.NetworkServiceEndpoint) (*registry.NetworkServiceEndpoint, error) {
+	callerID := caller.FromContext(ctx)
+	owner, err := s.check(ctx, nse.GetName(), callerID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Refreshes by admins keep the owner. The owner is written with every refresh, so server-side apply
+	// patches keep the annotation.
+	if owner == "" {
+		owner = callerID
+	}
+	if owner != "" {
+		ctx = registeredby.WithSpiffeID(ctx, owner)
+	}
+	return next.NetworkServiceEndpointRegistryServer(ctx).Register(ctx, nse)
+}
+
+func (s *ownershipNSEServer) Find(query *registry.NetworkServiceEndpointQuery, server registry.NetworkServiceEndpointRegistry_FindServer) error {
+	return next.NetworkServiceEndpointRegistryServer(server.Context()).Find(query, server)
+}
+
+func (s *ownershipNSEServer) Unregister(ctx context.Context, nse *registry.NetworkServiceEndpoint) (*empty.Empty, error) {
+	if _, err := s.check(ctx, nse.GetName(), caller.FromContext(ctx)); err != nil {
+		return nil, err
+	}
+	return next.NetworkServiceEndpointRegistryServer(ctx).Unregister(ctx, nse)
+}
+
+// check returns the owner of the NSE with the name and an error if the caller is not allowed to change it
+func (s *ownershipNSEServer) check(ctx context.Context, name, callerID string) (string, error) {
+	if name == "" {
+		return "", nil
+	}
+	nse, err := s.client.NetworkservicemeshV1().NetworkServiceEndpoints(s.namespace).Get(ctx, name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return "", nil
+	}
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to get the owner of NSE %s", name)
+	}
+	owner := nse.GetAnnotations()[Annotation]
+	if owner == "" || owner == callerID || slices.Contains(s.admins, callerID) {
+		return owner, nil
+	}
+	return owner, status.Errorf(codes.PermissionDenied, "NSE %s is owned by %s", name, owner)
+}
@@ -0,0 +1,196 @@
+// Copyright (c) 2026 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package quota
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/networkservicemesh/api/pkg/api/registry"
+
+	"github.com/networkservicemesh/sdk/pkg/registry/core/next"
+	"github.com/networkservicemesh/sdk/pkg/tools/log"
+
+	v1 "github.com/networkservicemesh/sdk-k8s/pkg/tools/k8s/apis/networkservicemesh.io/v1"
+	"github.com/networkservicemesh/sdk-k8s/pkg/tools/k8s/client/clientset/versioned"
+	"github.com/networkservicemesh/sdk-k8s/pkg/tools/k8s/client/informers/externalversions"
+
+	"github.com/networkservicemesh/cmd-registry-k8s/pkg/registry/common/ownership"
+	"github.com/networkservicemesh/cmd-registry-k8s/pkg/tools/caller"
+	"github.com/networkservicemesh/cmd-registry-k8s/pkg/tools/clientset/multinamespace"
+)
+
+const (
+	serviceIndex  = "service"
+	spiffeIDIndex = "spiffeID"
+)
+
+type quotaNSEServer struct {
+	namespace string
+	informer  cache.SharedIndexInformer
+	quotas    Quotas
+}
+
+// NewNetworkServiceEndpointRegistryServer creates a new chain element rejecting registrations of new NSEs with
+// ResourceExhausted if they would exceed the quotas. Refreshes of stored NSEs are never rejected. The NSEs are counted
+// in an informer cache of the namespace watched until ctx is done, indexed by namespace, network service and owner, so
+// concurrent registrations may exceed the quotas slightly. The quotas apply to the namespace the request is routed to
+// by multinamespace.WithNamespace, or to the default namespace. The quota per SPIFFE ID counts the NSEs annotated by the
+// ownership element, the caller is the SPIFFE ID of the first path segment, so the element must follow the path update
+// and authorization. It fails if the indexes can't be added to the informer.
+func NewNetworkServiceEndpointRegistryServer(ctx context.Context, client versioned.Interface, namespace string, quotas Quotas, opts ...Option) (registry.NetworkServiceEndpointRegistryServer, error) {
+	o := new(options)
+	for _, opt := range opts {
+		opt(o)
+	}
+	watchNamespace := namespace
+	if o.allNamespaces {
+		watchNamespace = metav1.NamespaceAll
+	}
+
+	factory := externalversions.NewSharedInformerFactoryWithOptions(client, 0, externalversions.WithNamespace(watchNamespace))
+	informer := factory.Networkservicemesh().V1().NetworkServiceEndpoints().Informer()
+	// The generated informer is indexed by namespace already
+	if err := informer.AddIndexers(cache.Indexers{
+		serviceIndex:  indexServices,
+		spiffeIDIndex: indexSpiffeID,
+	}); err != nil {
+		return nil, errors.Wrap(err, "failed to add the NSE quota indexes")
+	}
+	factory.Start(ctx.Done())
+
+	return &quotaNSEServer{
+		namespace: namespace,
+		informer:  informer,
+		quotas:    quotas,
+	}, nil
+}
+
+func (s *quotaNSEServer) Register(ctx context.Context, nse *registry.NetworkServiceEndpoint) (*registry.NetworkServiceEndpoint, error) {
+	if err := s.check(ctx, nse); err != nil {
+		return nil, err
+	}
+	return next.NetworkServiceEndpointRegistryServer(ctx).Register(ctx, nse)
+}
+
+func (s *quotaNSEServer) Find(query *registry.NetworkServiceEndpointQuery, server registry.NetworkServiceEndpointRegistry_FindServer) error {
+	return next.NetworkServiceEndpointRegistryServer(server.Context()).Find(query, server)
+}
+
+func (s *quotaNSEServer) Unregister(ctx context.Context, nse *registry.NetworkServiceEndpoint) (*empty.Empty, error) {
+	return next.NetworkServiceEndpointRegistryServer(ctx).Unregister(ctx, nse)
+}
+
+// check returns an error if the NSE is new and a quota is exhausted
+func (s *quotaNSEServer) check(ctx context.Context, nse *registry.NetworkServiceEndpoint) error {
+	if !cache.WaitForCacheSync(ctx.Done(), s.informer.HasSynced) {
+		return errors.Wrap(ctx.Err(), "failed to sync the NSE cache")
+	}
+	indexer := s.informer.GetIndexer()
+
+	namespace := multinamespace.NamespaceFromContext(ctx)
+	if namespace == "" {
+		namespace = s.namespace
+	}
+	if nse.GetName() != "" {
+		_, exists, err := indexer.GetByKey(namespace + "/" + nse.GetName())
+		if err != nil {
+			return errors.Wrapf(err, "failed to get NSE %s", nse.GetName())
+		}
+		if exists {
+			return nil
+		}
+	}
+
+	count := func(index, value string) (int, error) {
+		keys, err := indexer.IndexKeys(index, value)
+		return len(keys), errors.Wrapf(err, "failed to count NSEs by %s", index)
+	}
+	if s.quotas.PerNamespace > 0 {
+		n, err := count(cache.NamespaceIndex, namespace)
+		if err != nil {
+			return err
+		}
+		if n >= s.quotas.PerNamespace {
+			return s.reject(ctx, nse, "namespace", "namespace %s has %d NSEs", namespace, n)
+		}
+	}
+	if s.quotas.PerService > 0 {
+		for _, service := range nse.GetNetworkServiceNames() {
+			n, err := count(serviceIndex, namespace+"/"+service)
+			if err != nil {
+				return err
+			}
+			if n >= s.quotas.PerService {
+				return s.reject(ctx, nse, "service", "network service %s has %d NSEs", service, n)
+			}
+		}
+	}
+	if callerID := caller.FromContext(ctx); s.quotas.PerSpiffeID > 0 && callerID != "" {
+		n, err := count(spiffeIDIndex, namespace+"/"+callerID)
+		if err != nil {
+			return err
+		}
+		if n >= s.quotas.PerSpiffeID {
+			return s.reject(ctx, nse, "spiffe_id", "SPIFFE ID %s has registered %d NSEs", callerID, n)
+		}
+	}
+	return nil
+}
+
+func (s *quotaNSEServer) reject(ctx context.Context, nse *registry.NetworkServiceEndpoint, quota, format string, args ...interface{}) error {
+	if rejections != nil {
+		rejections.Add(ctx, 1, metric.WithAttributes(attribute.String("quota", quota)))
+	}
+	err := status.Errorf(codes.ResourceExhausted, "quota exceeded, NSE %s is not registered: "+format, append([]interface{}{nse.GetName()}, args...)...)
+	log.FromContext(ctx).WithField("quotaNSEServer", "Register").WithField("nse_name", nse.GetName()).Warnf("%v", err.Error())
+	return err
+}
+
+// indexServices indexes the NSE by the namespace and each of its network services
+func indexServices(obj interface{}) ([]string, error) {
+	nse, ok := obj.(*v1.NetworkServiceEndpoint)
+	if !ok {
+		return nil, nil
+	}
+	values := make([]string, 0, len(nse.Spec.NetworkServiceNames))
+	for _, service := range nse.Spec.NetworkServiceNames {
+		values = append(values, nse.GetNamespace()+"/"+service)
+	}
+	return values, nil
+}
+
+// indexSpiffeID indexes the NSE by the namespace and the SPIFFE ID owning it
+func indexSpiffeID(obj interface{}) ([]string, error) {
+	nse, ok := obj.(*v1.NetworkServiceEndpoint)
+	if !ok {
+		return nil, nil
+	}
+	owner := nse.GetAnnotations()[ownership.Annotation]
+	if owner == "" {
+		return nil, nil
+	}
+	return []string{nse.GetNamespace() + "/" + owner}, nil
+}
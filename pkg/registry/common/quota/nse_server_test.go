@@ -0,0 +1,123 @@
+// Copyright (c) 2026 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package quota_test
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8stesting "k8s.io/client-go/testing"
+
+	"github.com/networkservicemesh/api/pkg/api/registry"
+
+	"github.com/networkservicemesh/sdk/pkg/registry/core/next"
+
+	v1 "github.com/networkservicemesh/sdk-k8s/pkg/tools/k8s/apis/networkservicemesh.io/v1"
+	"github.com/networkservicemesh/sdk-k8s/pkg/tools/k8s/client/clientset/versioned/fake"
+
+	"github.com/networkservicemesh/cmd-registry-k8s/pkg/registry/common/quota"
+	"github.com/networkservicemesh/cmd-registry-k8s/pkg/tools/clientset/multinamespace"
+)
+
+const (
+	namespace      = "ns-1"
+	otherNamespace = "ns-2"
+)
+
+func newNSE(namespace, name string, services ...string) *v1.NetworkServiceEndpoint {
+	nse := &v1.NetworkServiceEndpoint{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+	}
+	nse.Spec.NetworkServiceNames = services
+	return nse
+}
+
+func TestQuotaNSEServer_Register(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	api := fake.NewSimpleClientset(
+		newNSE(namespace, "nse-1", "ns-a"),
+		newNSE(otherNamespace, "nse-2", "ns-b"),
+	)
+	var apiReads atomic.Int32
+	for _, verb := range []string{"get", "list"} {
+		api.PrependReactor(verb, "networkserviceendpoints", func(k8stesting.Action) (bool, runtime.Object, error) {
+			apiReads.Add(1)
+			return false, nil, nil
+		})
+	}
+
+	quotaServer, err := quota.NewNetworkServiceEndpointRegistryServer(ctx, api, namespace, quota.Quotas{PerNamespace: 2, PerService: 1}, quota.WithAllNamespaces())
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := next.NewNetworkServiceEndpointRegistryServer(quotaServer)
+	register := func(ctx context.Context, name string, services ...string) codes.Code {
+		_, err := server.Register(ctx, &registry.NetworkServiceEndpoint{Name: name, NetworkServiceNames: services})
+		return status.Code(err)
+	}
+
+	// The informer lists the NSEs once while syncing
+	if code := register(ctx, "nse-3", "ns-c"); code != codes.OK {
+		t.Fatalf("new NSE of a free service: %v, want OK", code)
+	}
+	readsAfterSync := apiReads.Load()
+
+	for _, tc := range []struct {
+		name     string
+		ctx      context.Context
+		nse      string
+		services []string
+		code     codes.Code
+	}{
+		{name: "refresh of a stored NSE", ctx: ctx, nse: "nse-1", services: []string{"ns-a"}, code: codes.OK},
+		{name: "service quota in the default namespace", ctx: ctx, nse: "nse-4", services: []string{"ns-a"}, code: codes.ResourceExhausted},
+		{name: "service quota in the routed namespace", ctx: multinamespace.WithNamespace(ctx, otherNamespace), nse: "nse-4", services: []string{"ns-b"}, code: codes.ResourceExhausted},
+		{name: "service of another namespace", ctx: multinamespace.WithNamespace(ctx, otherNamespace), nse: "nse-4", services: []string{"ns-a"}, code: codes.OK},
+		{name: "NSE stored in another namespace", ctx: ctx, nse: "nse-2", services: []string{"ns-d"}, code: codes.OK},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if code := register(tc.ctx, tc.nse, tc.services...); code != tc.code {
+				t.Fatalf("got %v, want %v", code, tc.code)
+			}
+		})
+	}
+
+	// NSEs registered through the chain aren't stored by its tail, the namespace quota is exhausted by a stored one
+	if _, err := api.NetworkservicemeshV1().NetworkServiceEndpoints(namespace).Create(ctx, newNSE(namespace, "nse-5"), metav1.CreateOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	for start := time.Now(); register(ctx, "nse-6") != codes.ResourceExhausted; time.Sleep(10 * time.Millisecond) {
+		if time.Since(start) > 5*time.Second {
+			t.Fatal("namespace quota is not exhausted by the stored NSEs")
+		}
+	}
+
+	if reads := apiReads.Load(); reads != readsAfterSync {
+		t.Fatalf("Register read the API server %d times, want 0", reads-readsAfterSync)
+	}
+}
@@ -0,0 +1,32 @@
+// Copyright (c) 2026 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package quota
+
+type options struct {
+	allNamespaces bool
+}
+
+// Option is an option pattern for NewNetworkServiceEndpointRegistryServer
+type Option func(o *options)
+
+// WithAllNamespaces makes the element watch NSEs of all namespaces instead of the default one, so NSEs of requests
+// routed to other namespaces by multinamespace.WithNamespace are counted against the quotas of those namespaces
+func WithAllNamespaces() Option {
+	return func(o *options) {
+		o.allNamespaces = true
+	}
+}
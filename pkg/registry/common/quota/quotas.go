@@ -0,0 +1,32 @@
+// Copyright (c) 2026 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package quota
+
+// Quotas are the maximum numbers of NSEs, 0 means no limit
+type Quotas struct {
+	// PerService limits the NSEs of each network service
+	PerService int
+	// PerNamespace limits the NSEs of the namespace the NSE is stored in
+	PerNamespace int
+	// PerSpiffeID limits the NSEs owned by the SPIFFE ID registering the NSE, see ownership.Annotation
+	PerSpiffeID int
+}
+
+// Enabled returns true if any quota is set
+func (q *Quotas) Enabled() bool {
+	return q.PerService > 0 || q.PerNamespace > 0 || q.PerSpiffeID > 0
+}
@@ -0,0 +1,119 @@
+// Copyright (c) 2026 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/client-go/util/flowcontrol"
+
+	"github.com/networkservicemesh/sdk/pkg/tools/clock"
+
+	"github.com/networkservicemesh/cmd-registry-k8s/pkg/tools/caller"
+)
+
+const (
+	methodRegister   = "Register"
+	methodUnregister = "Unregister"
+	methodFind       = "Find"
+
+	// idleTimeout is the time after which the buckets of a peer without requests are dropped
+	idleTimeout = 10 * time.Minute
+)
+
+// Budget is the token bucket of a method, 0 QPS means no limit
+type Budget struct {
+	QPS   float32
+	Burst int
+}
+
+type bucketKey struct {
+	peer, method string
+}
+
+type bucket struct {
+	limiter  flowcontrol.RateLimiter
+	lastUsed time.Time
+}
+
+// Limiter keeps a token bucket per peer SPIFFE ID and method. NSE and NS requests share the buckets.
+type Limiter struct {
+	budgets map[string]Budget
+
+	mu        sync.Mutex
+	buckets   map[bucketKey]*bucket
+	lastSweep time.Time
+}
+
+// NewLimiter returns the limiter with the budgets of Register, Unregister and Find requests of each peer
+func NewLimiter(register, unregister, find Budget) *Limiter {
+	return &Limiter{
+		budgets: map[string]Budget{
+			methodRegister:   register,
+			methodUnregister: unregister,
+			methodFind:       find,
+		},
+		buckets: make(map[bucketKey]*bucket),
+	}
+}
+
+// allow returns ResourceExhausted if the peer has no tokens left for the method. Peers without a SPIFFE ID share a
+// bucket.
+func (l *Limiter) allow(ctx context.Context, kind, method string) error {
+	budget := l.budgets[method]
+	if budget.QPS <= 0 {
+		return nil
+	}
+	key := bucketKey{
+		peer:   caller.PeerFromContext(ctx),
+		method: method,
+	}
+	now := clock.FromContext(ctx).Now()
+
+	l.mu.Lock()
+	if now.Sub(l.lastSweep) > idleTimeout {
+		for k, b := range l.buckets {
+			if now.Sub(b.lastUsed) > idleTimeout {
+				delete(l.buckets, k)
+			}
+		}
+		l.lastSweep = now
+	}
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &bucket{
+			limiter: flowcontrol.NewTokenBucketRateLimiter(budget.QPS, budget.Burst),
+		}
+		l.buckets[key] = b
+	}
+	b.lastUsed = now
+	l.mu.Unlock()
+
+	if b.limiter.TryAccept() {
+		return nil
+	}
+	if rejectedRequests != nil {
+		rejectedRequests.Add(ctx, 1, metric.WithAttributes(attribute.String("type", kind), attribute.String("method", method)))
+	}
+	return status.Errorf(codes.ResourceExhausted, "rate limit of %s requests of %q exceeded", method, key.peer)
+}
@@ -0,0 +1,41 @@
+// Copyright (c) 2026 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ratelimit
+
+import (
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/metric"
+)
+
+const (
+	meterName                   = "github.com/networkservicemesh/cmd-registry-k8s/pkg/registry/common/ratelimit"
+	rejectedRequestsCounterName = "registry_k8s_rate_limited_requests_total"
+)
+
+// rejectedRequests counts registry requests rejected by the rate limiter
+var rejectedRequests metric.Int64Counter
+
+func init() {
+	var err error
+	rejectedRequests, err = otel.Meter(meterName).Int64Counter(
+		rejectedRequestsCounterName,
+		metric.WithDescription("Number of registry requests rejected by the per-peer rate limiter"),
+	)
+	if err != nil {
+		otel.Handle(err)
+	}
+}
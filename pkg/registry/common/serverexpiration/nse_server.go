@@ -0,0 +1,84 @@
+// Copyright (c) 2026 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package serverexpiration
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/networkservicemesh/api/pkg/api/registry"
+
+	"github.com/networkservicemesh/sdk/pkg/registry/core/next"
+	"github.com/networkservicemesh/sdk/pkg/tools/clock"
+	"github.com/networkservicemesh/sdk/pkg/tools/log"
+	"github.com/networkservicemesh/sdk/pkg/tools/token"
+)
+
+type serverExpirationNSEServer struct {
+	peerTokenLifetime time.Duration
+}
+
+// NewNetworkServiceEndpointRegistryServer creates a new chain element replacing the expiration time of registered NSEs
+// with the registry time + the TTL requested by the peer. The TTL is measured in the clock of the peer, as the time
+// between the expiration time and the time the peer has sent the request, which is the expiration time of the peer
+// token - peerTokenLifetime. The expiration time of the response is moved back to the clock of the peer, so the peer
+// schedules its refreshes as usual. NSEs registered without an expiration time or a peer token are kept unchanged.
+func NewNetworkServiceEndpointRegistryServer(peerTokenLifetime time.Duration) registry.NetworkServiceEndpointRegistryServer {
+	return &serverExpirationNSEServer{
+		peerTokenLifetime: peerTokenLifetime,
+	}
+}
+
+func (s *serverExpirationNSEServer) Register(ctx context.Context, nse *registry.NetworkServiceEndpoint) (*registry.NetworkServiceEndpoint, error) {
+	if nse.GetExpirationTime() == nil {
+		return next.NetworkServiceEndpointRegistryServer(ctx).Register(ctx, nse)
+	}
+	logger := log.FromContext(ctx).WithField("serverExpirationNSEServer", "Register")
+	_, peerTokenExpirationTime, err := token.FromContext(ctx)
+	if err != nil {
+		logger.Debugf("kept expiration time of %s, no peer token: %v", nse.GetName(), err.Error())
+		return next.NetworkServiceEndpointRegistryServer(ctx).Register(ctx, nse)
+	}
+
+	sentTime := peerTokenExpirationTime.Add(-s.peerTokenLifetime)
+	ttl := nse.GetExpirationTime().AsTime().Sub(sentTime)
+	now := clock.FromContext(ctx).Now()
+	// offset is the time the registry clock is ahead of the peer clock, including the request latency
+	offset := now.Sub(sentTime)
+	nse.ExpirationTime = timestamppb.New(now.Add(ttl))
+	logger.Debugf("set expiration time of %s to %v, TTL %v, peer clock offset %v", nse.GetName(), nse.GetExpirationTime().AsTime(), ttl, offset)
+
+	resp, err := next.NetworkServiceEndpointRegistryServer(ctx).Register(ctx, nse)
+	if err != nil {
+		return nil, err
+	}
+	if resp.GetExpirationTime() != nil {
+		resp.ExpirationTime = timestamppb.New(resp.GetExpirationTime().AsTime().Add(-offset))
+	}
+	return resp, nil
+}
+
+func (s *serverExpirationNSEServer) Find(query *registry.NetworkServiceEndpointQuery, server registry.NetworkServiceEndpointRegistry_FindServer) error {
+	return next.NetworkServiceEndpointRegistryServer(server.Context()).Find(query, server)
+}
+
+func (s *serverExpirationNSEServer) Unregister(ctx context.Context, nse *registry.NetworkServiceEndpoint) (*empty.Empty, error) {
+	return next.NetworkServiceEndpointRegistryServer(ctx).Unregister(ctx, nse)
+}
@@ -0,0 +1,66 @@
+// Copyright (c) 2026 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package slowrequest
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/empty"
+
+	"github.com/networkservicemesh/api/pkg/api/registry"
+
+	"github.com/networkservicemesh/sdk/pkg/registry/core/next"
+	"github.com/networkservicemesh/sdk/pkg/registry/core/streamcontext"
+)
+
+type slowRequestNSServer struct {
+	threshold time.Duration
+}
+
+// NewNetworkServiceRegistryServer creates a new chain element logging and counting Register / Unregister and
+// non-watching Find calls taking longer than threshold
+func NewNetworkServiceRegistryServer(threshold time.Duration) registry.NetworkServiceRegistryServer {
+	return &slowRequestNSServer{
+		threshold: threshold,
+	}
+}
+
+func (s *slowRequestNSServer) Register(ctx context.Context, ns *registry.NetworkService) (*registry.NetworkService, error) {
+	ctx, r := start(ctx, "ns", "Register", ns.GetName(), s.threshold)
+	resp, err := next.NetworkServiceRegistryServer(ctx).Register(ctx, ns)
+	r.done(err)
+	return resp, err
+}
+
+func (s *slowRequestNSServer) Find(query *registry.NetworkServiceQuery, server registry.NetworkServiceRegistry_FindServer) error {
+	// Watches last until the client cancels them
+	if query.GetWatch() {
+		return next.NetworkServiceRegistryServer(server.Context()).Find(query, server)
+	}
+	ctx, r := start(server.Context(), "ns", "Find", query.GetNetworkService().GetName(), s.threshold)
+	err := next.NetworkServiceRegistryServer(ctx).Find(query, streamcontext.NetworkServiceRegistryFindServer(ctx, server))
+	r.done(err)
+	return err
+}
+
+func (s *slowRequestNSServer) Unregister(ctx context.Context, ns *registry.NetworkService) (*empty.Empty, error) {
+	ctx, r := start(ctx, "ns", "Unregister", ns.GetName(), s.threshold)
+	resp, err := next.NetworkServiceRegistryServer(ctx).Unregister(ctx, ns)
+	r.done(err)
+	return resp, err
+}
@@ -0,0 +1,66 @@
+// Copyright (c) 2026 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package slowrequest
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/empty"
+
+	"github.com/networkservicemesh/api/pkg/api/registry"
+
+	"github.com/networkservicemesh/sdk/pkg/registry/core/next"
+	"github.com/networkservicemesh/sdk/pkg/registry/core/streamcontext"
+)
+
+type slowRequestNSEServer struct {
+	threshold time.Duration
+}
+
+// NewNetworkServiceEndpointRegistryServer creates a new chain element logging and counting Register / Unregister and
+// non-watching Find calls taking longer than threshold
+func NewNetworkServiceEndpointRegistryServer(threshold time.Duration) registry.NetworkServiceEndpointRegistryServer {
+	return &slowRequestNSEServer{
+		threshold: threshold,
+	}
+}
+
+func (s *slowRequestNSEServer) Register(ctx context.Context, nse *registry.NetworkServiceEndpoint) (*registry.NetworkServiceEndpoint, error) {
+	ctx, r := start(ctx, "nse", "Register", nse.GetName(), s.threshold)
+	resp, err := next.NetworkServiceEndpointRegistryServer(ctx).Register(ctx, nse)
+	r.done(err)
+	return resp, err
+}
+
+func (s *slowRequestNSEServer) Find(query *registry.NetworkServiceEndpointQuery, server registry.NetworkServiceEndpointRegistry_FindServer) error {
+	// Watches last until the client cancels them
+	if query.GetWatch() {
+		return next.NetworkServiceEndpointRegistryServer(server.Context()).Find(query, server)
+	}
+	ctx, r := start(server.Context(), "nse", "Find", query.GetNetworkServiceEndpoint().GetName(), s.threshold)
+	err := next.NetworkServiceEndpointRegistryServer(ctx).Find(query, streamcontext.NetworkServiceEndpointRegistryFindServer(ctx, server))
+	r.done(err)
+	return err
+}
+
+func (s *slowRequestNSEServer) Unregister(ctx context.Context, nse *registry.NetworkServiceEndpoint) (*empty.Empty, error) {
+	ctx, r := start(ctx, "nse", "Unregister", nse.GetName(), s.threshold)
+	resp, err := next.NetworkServiceEndpointRegistryServer(ctx).Unregister(ctx, nse)
+	r.done(err)
+	return resp, err
+}
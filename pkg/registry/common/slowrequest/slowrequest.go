@@ -0,0 +1,116 @@
+// Copyright (c) 2026 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package slowrequest
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+
+	"github.com/networkservicemesh/sdk/pkg/tools/log"
+
+	"github.com/networkservicemesh/cmd-registry-k8s/pkg/registry/common/timing"
+)
+
+const (
+	meterName               = "github.com/networkservicemesh/cmd-registry-k8s/pkg/registry/common/slowrequest"
+	slowRequestsCounterName = "registry_k8s_slow_requests_total"
+
+	// maxLoggedCalls limits the downstream calls listed in the logs of a slow request
+	maxLoggedCalls = 5
+)
+
+var slowRequestsCounter metric.Int64Counter
+
+func init() {
+	var err error
+	if slowRequestsCounter, err = otel.Meter(meterName).Int64Counter(
+		slowRequestsCounterName,
+		metric.WithDescription("Number of registry requests slower than the slow request threshold by type and method"),
+	); err != nil {
+		otel.Handle(err)
+	}
+}
+
+// request is a registry request timed by a slowrequest chain element
+type request struct {
+	ctx       context.Context
+	kind      string
+	method    string
+	name      string
+	threshold time.Duration
+	start     time.Time
+	calls     func() []timing.Call
+}
+
+// start returns the request and the context collecting its downstream calls
+func start(ctx context.Context, kind, method, name string, threshold time.Duration) (context.Context, *request) {
+	ctx, calls := timing.WithCalls(ctx)
+	return ctx, &request{
+		ctx:       ctx,
+		kind:      kind,
+		method:    method,
+		name:      name,
+		threshold: threshold,
+		start:     time.Now(),
+		calls:     calls,
+	}
+}
+
+// done logs and counts the request if it took longer than the threshold
+func (r *request) done(err error) {
+	elapsed := time.Since(r.start)
+	if elapsed <= r.threshold {
+		return
+	}
+
+	if slowRequestsCounter != nil {
+		slowRequestsCounter.Add(r.ctx, 1,
+			metric.WithAttributes(attribute.String("type", r.kind), attribute.String("method", r.method)))
+	}
+
+	logger := log.FromContext(r.ctx).WithField("slowrequest", r.method)
+	if r.kind == "nse" && r.name != "" {
+		logger = logger.WithField("nse_name", r.name)
+	}
+	result := "succeeded"
+	if err != nil {
+		result = "failed: " + err.Error()
+	}
+	logger.Warnf("%s of %s %s took %s, longer than %s, and %s; downstream calls: %s",
+		r.method, strings.ToUpper(r.kind), r.name, elapsed.Round(time.Millisecond), r.threshold, result, formatCalls(r.calls()))
+}
+
+func formatCalls(calls []timing.Call) string {
+	if len(calls) == 0 {
+		return "none timed"
+	}
+	var parts []string
+	for i, call := range calls {
+		if i == maxLoggedCalls {
+			parts = append(parts, fmt.Sprintf("%d more", len(calls)-maxLoggedCalls))
+			break
+		}
+		parts = append(parts, fmt.Sprintf("%s.%s %s (%d calls)", call.Element, call.Method, call.Duration.Round(time.Millisecond), call.Count))
+	}
+	return strings.Join(parts, ", ")
+}
@@ -0,0 +1,51 @@
+// Copyright (c) 2026 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stats
+
+import "sync/atomic"
+
+// Counters is a set of registry call counters shared by the chain elements
+type Counters struct {
+	registrations   atomic.Int64
+	unregistrations atomic.Int64
+	finds           atomic.Int64
+	errors          atomic.Int64
+}
+
+// Snapshot is a point in time copy of Counters
+type Snapshot struct {
+	Registrations   int64
+	Unregistrations int64
+	Finds           int64
+	Errors          int64
+}
+
+// Snapshot returns the current values of the counters
+func (c *Counters) Snapshot() Snapshot {
+	return Snapshot{
+		Registrations:   c.registrations.Load(),
+		Unregistrations: c.unregistrations.Load(),
+		Finds:           c.finds.Load(),
+		Errors:          c.errors.Load(),
+	}
+}
+
+func (c *Counters) countError(err error) {
+	if err != nil {
+		c.errors.Add(1)
+	}
+}
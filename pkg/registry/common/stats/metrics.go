@@ -0,0 +1,124 @@
+// Copyright (c) 2026 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stats
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+const (
+	meterName                = "github.com/networkservicemesh/cmd-registry-k8s/pkg/registry/common/stats"
+	requestsCounterName      = "registry_k8s_requests_total"
+	requestDurationHistoName = "registry_k8s_request_duration_seconds"
+	registrationsCounterName = "registry_k8s_nse_registrations_total"
+	conflictsCounterName     = "registry_k8s_nse_unregister_conflicts_total"
+	findStreamsGaugeName     = "registry_k8s_find_streams_active"
+)
+
+var (
+	requestsCounter      metric.Int64Counter
+	requestsHistogram    metric.Float64Histogram
+	registrationsCounter metric.Int64Counter
+	conflictsCounter     metric.Int64Counter
+	findStreamsGauge     metric.Int64UpDownCounter
+)
+
+func init() {
+	meter := otel.Meter(meterName)
+	var err error
+	if requestsCounter, err = meter.Int64Counter(
+		requestsCounterName,
+		metric.WithDescription("Number of NSE registry requests by method and result"),
+	); err != nil {
+		otel.Handle(err)
+	}
+	if requestsHistogram, err = meter.Float64Histogram(
+		requestDurationHistoName,
+		metric.WithDescription("Duration of NSE registry requests by method"),
+		metric.WithUnit("s"),
+	); err != nil {
+		otel.Handle(err)
+	}
+	if registrationsCounter, err = meter.Int64Counter(
+		registrationsCounterName,
+		metric.WithDescription("Number of successful NSE registrations and refreshes by namespace and network service"),
+	); err != nil {
+		otel.Handle(err)
+	}
+	if conflictsCounter, err = meter.Int64Counter(
+		conflictsCounterName,
+		metric.WithDescription("Number of NSE unregistrations failed because of a conflict by namespace"),
+	); err != nil {
+		otel.Handle(err)
+	}
+	if findStreamsGauge, err = meter.Int64UpDownCounter(
+		findStreamsGaugeName,
+		metric.WithDescription("Number of active NSE Find streams by namespace"),
+	); err != nil {
+		otel.Handle(err)
+	}
+}
+
+// record records the request of the method started at start and finished with err
+func record(ctx context.Context, method string, start time.Time, err error) {
+	result := "ok"
+	if err != nil {
+		result = "error"
+	}
+	if requestsCounter != nil {
+		requestsCounter.Add(ctx, 1, metric.WithAttributes(attribute.String("method", method), attribute.String("result", result)))
+	}
+	if requestsHistogram != nil {
+		requestsHistogram.Record(ctx, time.Since(start).Seconds(), metric.WithAttributes(attribute.String("method", method)))
+	}
+}
+
+// recordRegistration records the successful registration of the NSE with a network service label per its service
+func recordRegistration(ctx context.Context, namespace string, services []string) {
+	if registrationsCounter == nil {
+		return
+	}
+	for _, service := range services {
+		registrationsCounter.Add(ctx, 1, metric.WithAttributes(attribute.String("namespace", namespace), attribute.String("service", service)))
+	}
+}
+
+// recordUnregisterConflict records the unregistration failed with err, if it is a conflict
+func recordUnregisterConflict(ctx context.Context, namespace string, err error) {
+	if conflictsCounter == nil || (!apierrors.IsConflict(err) && status.Code(err) != codes.Aborted) {
+		return
+	}
+	conflictsCounter.Add(ctx, 1, metric.WithAttributes(attribute.String("namespace", namespace)))
+}
+
+// recordFindStream records the start of a Find stream and returns the function recording its end
+func recordFindStream(ctx context.Context, namespace string) func() {
+	if findStreamsGauge == nil {
+		return func() {}
+	}
+	attributes := metric.WithAttributes(attribute.String("namespace", namespace))
+	findStreamsGauge.Add(ctx, 1, attributes)
+	return func() { findStreamsGauge.Add(context.Background(), -1, attributes) }
+}
@@ -0,0 +1,74 @@
+// Copyright (c) 2026 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stats
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/empty"
+
+	"github.com/networkservicemesh/api/pkg/api/registry"
+
+	"github.com/networkservicemesh/sdk/pkg/registry/core/next"
+)
+
+type statsNSEServer struct {
+	counters  *Counters
+	namespace string
+}
+
+// NewNetworkServiceEndpointRegistryServer creates a new chain element counting Register / Unregister / Find calls
+// and their errors into counters. Metrics of the NSEs are labeled with the registry namespace.
+func NewNetworkServiceEndpointRegistryServer(counters *Counters, namespace string) registry.NetworkServiceEndpointRegistryServer {
+	return &statsNSEServer{
+		counters:  counters,
+		namespace: namespace,
+	}
+}
+
+func (s *statsNSEServer) Register(ctx context.Context, nse *registry.NetworkServiceEndpoint) (*registry.NetworkServiceEndpoint, error) {
+	start := time.Now()
+	s.counters.registrations.Add(1)
+	resp, err := next.NetworkServiceEndpointRegistryServer(ctx).Register(ctx, nse)
+	s.counters.countError(err)
+	record(ctx, "register", start, err)
+	if err == nil {
+		recordRegistration(ctx, s.namespace, resp.GetNetworkServiceNames())
+	}
+	return resp, err
+}
+
+func (s *statsNSEServer) Find(query *registry.NetworkServiceEndpointQuery, server registry.NetworkServiceEndpointRegistry_FindServer) error {
+	start := time.Now()
+	s.counters.finds.Add(1)
+	defer recordFindStream(server.Context(), s.namespace)()
+	err := next.NetworkServiceEndpointRegistryServer(server.Context()).Find(query, server)
+	s.counters.countError(err)
+	record(server.Context(), "find", start, err)
+	return err
+}
+
+func (s *statsNSEServer) Unregister(ctx context.Context, nse *registry.NetworkServiceEndpoint) (*empty.Empty, error) {
+	start := time.Now()
+	s.counters.unregistrations.Add(1)
+	resp, err := next.NetworkServiceEndpointRegistryServer(ctx).Unregister(ctx, nse)
+	s.counters.countError(err)
+	record(ctx, "unregister", start, err)
+	recordUnregisterConflict(ctx, s.namespace, err)
+	return resp, err
+}
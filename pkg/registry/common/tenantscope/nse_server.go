@@ -0,0 +1,117 @@
+// Copyright (c) 2026 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tenantscope
+
+import (
+	"context"
+	"slices"
+
+	"github.com/golang/protobuf/ptypes/empty"
+
+	"github.com/networkservicemesh/api/pkg/api/registry"
+
+	"github.com/networkservicemesh/sdk/pkg/registry/core/next"
+	"github.com/networkservicemesh/sdk/pkg/tools/log"
+
+	"github.com/networkservicemesh/cmd-registry-k8s/pkg/tools/caller"
+)
+
+type tenantScopeNSEServer struct {
+	label  string
+	admins []string
+}
+
+// NewNetworkServiceEndpointRegistryServer creates a new chain element isolating tenants. The tenant of a client is the
+// namespace of its SPIFFE ID in the spiffe://<trust domain>/ns/<namespace>/... form. Register sets the label of all
+// network services of the NSE to the tenant of the registering client, overriding the value sent by the client, and
+// Find returns only NSEs with the label selecting the tenant of the client. NSEs and clients without a tenant only see
+// each other, admins see NSEs of all tenants. The caller is the SPIFFE ID of the first path segment, so the element
+// must follow the path update and authorization.
+func NewNetworkServiceEndpointRegistryServer(label string, admins ...string) registry.NetworkServiceEndpointRegistryServer {
+	return &tenantScopeNSEServer{
+		label:  label,
+		admins: admins,
+	}
+}
+
+func (s *tenantScopeNSEServer) Register(ctx context.Context, nse *registry.NetworkServiceEndpoint) (*registry.NetworkServiceEndpoint, error) {
+	tenant := caller.Namespace(caller.FromContext(ctx))
+	for _, name := range nse.GetNetworkServiceNames() {
+		labels := nse.GetNetworkServiceLabels()[name]
+		if tenant == "" {
+			if labels != nil {
+				delete(labels.Labels, s.label)
+			}
+			continue
+		}
+		if labels == nil {
+			labels = new(registry.NetworkServiceLabels)
+			if nse.NetworkServiceLabels == nil {
+				nse.NetworkServiceLabels = make(map[string]*registry.NetworkServiceLabels)
+			}
+			nse.NetworkServiceLabels[name] = labels
+		}
+		if labels.Labels == nil {
+			labels.Labels = make(map[string]string)
+		}
+		if value, ok := labels.Labels[s.label]; ok && value != tenant {
+			log.FromContext(ctx).WithField("tenantScopeNSEServer", "Register").
+				Debugf("overrode tenant %s of %s with %s", value, nse.GetName(), tenant)
+		}
+		labels.Labels[s.label] = tenant
+	}
+	return next.NetworkServiceEndpointRegistryServer(ctx).Register(ctx, nse)
+}
+
+func (s *tenantScopeNSEServer) Find(query *registry.NetworkServiceEndpointQuery, server registry.NetworkServiceEndpointRegistry_FindServer) error {
+	callerID := caller.FromContext(server.Context())
+	if callerID != "" && slices.Contains(s.admins, callerID) {
+		return next.NetworkServiceEndpointRegistryServer(server.Context()).Find(query, server)
+	}
+	return next.NetworkServiceEndpointRegistryServer(server.Context()).Find(query, &tenantScopeFindServer{
+		NetworkServiceEndpointRegistry_FindServer: server,
+		label:  s.label,
+		tenant: caller.Namespace(callerID),
+	})
+}
+
+func (s *tenantScopeNSEServer) Unregister(ctx context.Context, nse *registry.NetworkServiceEndpoint) (*empty.Empty, error) {
+	return next.NetworkServiceEndpointRegistryServer(ctx).Unregister(ctx, nse)
+}
+
+type tenantScopeFindServer struct {
+	registry.NetworkServiceEndpointRegistry_FindServer
+	label  string
+	tenant string
+}
+
+func (s *tenantScopeFindServer) Send(resp *registry.NetworkServiceEndpointResponse) error {
+	if tenantOf(resp.GetNetworkServiceEndpoint(), s.label) != s.tenant {
+		return nil
+	}
+	return s.NetworkServiceEndpointRegistry_FindServer.Send(resp)
+}
+
+// tenantOf returns the tenant set by Register in the labels of the network services of the NSE
+func tenantOf(nse *registry.NetworkServiceEndpoint, label string) string {
+	for _, labels := range nse.GetNetworkServiceLabels() {
+		if tenant := labels.GetLabels()[label]; tenant != "" {
+			return tenant
+		}
+	}
+	return ""
+}
@@ -0,0 +1,79 @@
+// Copyright (c) 2026 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package timing
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Call is the time spent in the timed calls of an element method, excluding the timed elements they call
+type Call struct {
+	Element  string
+	Method   string
+	Count    int
+	Duration time.Duration
+}
+
+type callsKey struct{}
+
+type calls struct {
+	mu    sync.Mutex
+	calls map[[2]string]*Call
+}
+
+// WithCalls returns the context collecting the calls timed with Start under it and the function returning them,
+// longest first
+func WithCalls(ctx context.Context) (context.Context, func() []Call) {
+	c := &calls{
+		calls: make(map[[2]string]*Call),
+	}
+	return context.WithValue(ctx, callsKey{}, c), c.sorted
+}
+
+func (c *calls) add(element, method string, d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	key := [2]string{element, method}
+	call, ok := c.calls[key]
+	if !ok {
+		call = &Call{
+			Element: element,
+			Method:  method,
+		}
+		c.calls[key] = call
+	}
+	call.Count++
+	call.Duration += d
+}
+
+func (c *calls) sorted() []Call {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	result := make([]Call, 0, len(c.calls))
+	for _, call := range c.calls {
+		result = append(result, *call)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Duration > result[j].Duration
+	})
+	return result
+}
@@ -0,0 +1,76 @@
+// Copyright (c) 2026 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package timing
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+const (
+	meterName                = "github.com/networkservicemesh/cmd-registry-k8s/pkg/registry/common/timing"
+	elementDurationHistoName = "registry_k8s_chain_element_duration_seconds"
+)
+
+var elementDurationHistogram metric.Float64Histogram
+
+func init() {
+	var err error
+	if elementDurationHistogram, err = otel.Meter(meterName).Float64Histogram(
+		elementDurationHistoName,
+		metric.WithDescription("Time spent in registry chain elements and k8s API calls by element and method, excluding the timed elements they call"),
+		metric.WithUnit("s"),
+	); err != nil {
+		otel.Handle(err)
+	}
+}
+
+type frameKey struct{}
+
+// frame accumulates the time spent in the timed elements called by a timed element
+type frame struct {
+	children atomic.Int64
+}
+
+// Start starts timing a call of the method of a chain element. The returned context must be passed to the calls made by
+// the element, the returned function must be called when the element returns. The recorded duration excludes the time
+// spent in the timed elements called with the returned context, so the time of each element is recorded separately,
+// and is added to the calls collected by WithCalls.
+func Start(ctx context.Context, element, method string) (context.Context, func()) {
+	parent, _ := ctx.Value(frameKey{}).(*frame)
+	f := new(frame)
+	start := time.Now()
+	return context.WithValue(ctx, frameKey{}, f), func() {
+		total := time.Since(start)
+		if parent != nil {
+			parent.children.Add(int64(total))
+		}
+		self := total - time.Duration(f.children.Load())
+		if c, ok := ctx.Value(callsKey{}).(*calls); ok {
+			c.add(element, method, self)
+		}
+		if elementDurationHistogram != nil {
+			elementDurationHistogram.Record(ctx, self.Seconds(),
+				metric.WithAttributes(attribute.String("element", element), attribute.String("method", method)))
+		}
+	}
+}
@@ -0,0 +1,63 @@
+// Copyright (c) 2026 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package timing
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/ptypes/empty"
+
+	"github.com/networkservicemesh/api/pkg/api/registry"
+
+	"github.com/networkservicemesh/sdk/pkg/registry/core/streamcontext"
+)
+
+type timingNSServer struct {
+	name   string
+	server registry.NetworkServiceRegistryServer
+}
+
+// NewNetworkServiceRegistryServer creates a new chain element recording the latency of the Register / Unregister /
+// non-watch Find calls of server under the name
+func NewNetworkServiceRegistryServer(name string, server registry.NetworkServiceRegistryServer) registry.NetworkServiceRegistryServer {
+	return &timingNSServer{
+		name:   name,
+		server: server,
+	}
+}
+
+func (s *timingNSServer) Register(ctx context.Context, ns *registry.NetworkService) (*registry.NetworkService, error) {
+	ctx, done := Start(ctx, s.name, "Register")
+	defer done()
+	return s.server.Register(ctx, ns)
+}
+
+func (s *timingNSServer) Find(query *registry.NetworkServiceQuery, server registry.NetworkServiceRegistry_FindServer) error {
+	// Watches last until the client cancels them
+	if query.GetWatch() {
+		return s.server.Find(query, server)
+	}
+	ctx, done := Start(server.Context(), s.name, "Find")
+	defer done()
+	return s.server.Find(query, streamcontext.NetworkServiceRegistryFindServer(ctx, server))
+}
+
+func (s *timingNSServer) Unregister(ctx context.Context, ns *registry.NetworkService) (*empty.Empty, error) {
+	ctx, done := Start(ctx, s.name, "Unregister")
+	defer done()
+	return s.server.Unregister(ctx, ns)
+}
@@ -0,0 +1,63 @@
+// Copyright (c) 2026 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package timing
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/ptypes/empty"
+
+	"github.com/networkservicemesh/api/pkg/api/registry"
+
+	"github.com/networkservicemesh/sdk/pkg/registry/core/streamcontext"
+)
+
+type timingNSEServer struct {
+	name   string
+	server registry.NetworkServiceEndpointRegistryServer
+}
+
+// NewNetworkServiceEndpointRegistryServer creates a new chain element recording the latency of the Register /
+// Unregister / non-watch Find calls of server under the name
+func NewNetworkServiceEndpointRegistryServer(name string, server registry.NetworkServiceEndpointRegistryServer) registry.NetworkServiceEndpointRegistryServer {
+	return &timingNSEServer{
+		name:   name,
+		server: server,
+	}
+}
+
+func (s *timingNSEServer) Register(ctx context.Context, nse *registry.NetworkServiceEndpoint) (*registry.NetworkServiceEndpoint, error) {
+	ctx, done := Start(ctx, s.name, "Register")
+	defer done()
+	return s.server.Register(ctx, nse)
+}
+
+func (s *timingNSEServer) Find(query *registry.NetworkServiceEndpointQuery, server registry.NetworkServiceEndpointRegistry_FindServer) error {
+	// Watches last until the client cancels them
+	if query.GetWatch() {
+		return s.server.Find(query, server)
+	}
+	ctx, done := Start(server.Context(), s.name, "Find")
+	defer done()
+	return s.server.Find(query, streamcontext.NetworkServiceEndpointRegistryFindServer(ctx, server))
+}
+
+func (s *timingNSEServer) Unregister(ctx context.Context, nse *registry.NetworkServiceEndpoint) (*empty.Empty, error) {
+	ctx, done := Start(ctx, s.name, "Unregister")
+	defer done()
+	return s.server.Unregister(ctx, nse)
+}
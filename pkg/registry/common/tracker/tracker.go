@@ -0,0 +1,146 @@
+// Copyright (c) 2026 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tracker
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/networkservicemesh/api/pkg/api/registry"
+)
+
+// Tracker is a set of NSEs registered through the registry and not unregistered yet and of active Find streams, shared
+// by the chain elements
+type Tracker struct {
+	nses        map[string]NSE
+	findStreams map[uint64]FindStream
+	lastID      uint64
+	mu          sync.Mutex
+}
+
+// NSE is a managed NSE
+type NSE struct {
+	Name           string    `json:"name"`
+	URL            string    `json:"url"`
+	ExpirationTime time.Time `json:"expirationTime,omitempty"`
+	RegisteredAt   time.Time `json:"registeredAt"`
+}
+
+// FindStream is an active Find stream
+type FindStream struct {
+	Name      string    `json:"name,omitempty"`
+	Services  []string  `json:"networkServiceNames,omitempty"`
+	Watch     bool      `json:"watch"`
+	StartedAt time.Time `json:"startedAt"`
+}
+
+// Snapshot is a point in time copy of the Tracker state
+type Snapshot struct {
+	NSEs        []NSE        `json:"nses"`
+	FindStreams []FindStream `json:"findStreams"`
+}
+
+// New returns an empty Tracker
+func New() *Tracker {
+	return &Tracker{
+		nses:        make(map[string]NSE),
+		findStreams: make(map[uint64]FindStream),
+	}
+}
+
+// Names returns the names of the managed NSEs
+func (t *Tracker) Names() []string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	names := make([]string, 0, len(t.nses))
+	for name := range t.nses {
+		names = append(names, name)
+	}
+	return names
+}
+
+// Snapshot returns the managed NSEs sorted by their expiration time
+func (t *Tracker) Snapshot() Snapshot {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	s := Snapshot{
+		NSEs: make([]NSE, 0, len(t.nses)),
+	}
+	for _, nse := range t.nses {
+		s.NSEs = append(s.NSEs, nse)
+	}
+	sort.Slice(s.NSEs, func(i, j int) bool {
+		return s.NSEs[i].ExpirationTime.Before(s.NSEs[j].ExpirationTime)
+	})
+
+	s.FindStreams = make([]FindStream, 0, len(t.findStreams))
+	for _, stream := range t.findStreams {
+		s.FindStreams = append(s.FindStreams, stream)
+	}
+	sort.Slice(s.FindStreams, func(i, j int) bool {
+		return s.FindStreams[i].StartedAt.Before(s.FindStreams[j].StartedAt)
+	})
+	return s
+}
+
+func (t *Tracker) register(nse *registry.NetworkServiceEndpoint) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	managed := NSE{
+		Name:         nse.GetName(),
+		URL:          nse.GetUrl(),
+		RegisteredAt: time.Now(),
+	}
+	if nse.GetExpirationTime() != nil {
+		managed.ExpirationTime = nse.GetExpirationTime().AsTime().Local()
+	}
+	if old, ok := t.nses[managed.Name]; ok {
+		managed.RegisteredAt = old.RegisteredAt
+	}
+	t.nses[managed.Name] = managed
+}
+
+func (t *Tracker) unregister(nse *registry.NetworkServiceEndpoint) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	delete(t.nses, nse.GetName())
+}
+
+// startFind records the Find stream with the query and returns the function removing it
+func (t *Tracker) startFind(query *registry.NetworkServiceEndpointQuery) func() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.lastID++
+	id := t.lastID
+	t.findStreams[id] = FindStream{
+		Name:      query.GetNetworkServiceEndpoint().GetName(),
+		Services:  query.GetNetworkServiceEndpoint().GetNetworkServiceNames(),
+		Watch:     query.GetWatch(),
+		StartedAt: time.Now(),
+	}
+	return func() {
+		t.mu.Lock()
+		defer t.mu.Unlock()
+		delete(t.findStreams, id)
+	}
+}
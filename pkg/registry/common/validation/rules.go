@@ -0,0 +1,114 @@
+// Copyright (c) 2026 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	k8svalidation "k8s.io/apimachinery/pkg/util/validation"
+
+	"github.com/networkservicemesh/api/pkg/api/registry"
+)
+
+// Rules are the rules registered NSEs and NSs must follow. Zero values disable the corresponding rule.
+type Rules struct {
+	// URLSchemes are the allowed schemes of NSE URLs
+	URLSchemes []string
+	// MaxExpiration limits how far in the future NSE expiration times are
+	MaxExpiration time.Duration
+	// MaxLabels limits the labels of each network service of an NSE and of each selector of an NS
+	MaxLabels int
+	// MaxLabelLength limits the length of label keys and values
+	MaxLabelLength int
+}
+
+// name returns an error if the name is empty or isn't a valid Kubernetes object name. Only the name part of
+// interdomain names in the name@domain form is validated.
+func name(kind, name string) error {
+	if name == "" {
+		return errors.Errorf("%s name must not be empty", kind)
+	}
+	local, _, _ := strings.Cut(name, "@")
+	if msgs := k8svalidation.IsDNS1123Subdomain(local); len(msgs) > 0 {
+		return errors.Errorf("invalid %s name %q: %s", kind, name, strings.Join(msgs, ", "))
+	}
+	return nil
+}
+
+// nse returns an error if the NSE breaks the rules
+func (r *Rules) nse(nse *registry.NetworkServiceEndpoint, now time.Time) error {
+	if err := name("NSE", nse.GetName()); err != nil {
+		return err
+	}
+	if len(r.URLSchemes) > 0 && nse.GetUrl() != "" {
+		u, err := url.Parse(nse.GetUrl())
+		if err != nil {
+			return errors.Errorf("invalid URL %q of NSE %s: %v", nse.GetUrl(), nse.GetName(), err.Error())
+		}
+		if !slices.Contains(r.URLSchemes, u.Scheme) {
+			return errors.Errorf("URL %q of NSE %s has scheme %q, allowed schemes: %s", nse.GetUrl(), nse.GetName(), u.Scheme,
+				strings.Join(r.URLSchemes, ", "))
+		}
+	}
+	if r.MaxExpiration > 0 && nse.GetExpirationTime() != nil && nse.GetExpirationTime().AsTime().After(now.Add(r.MaxExpiration)) {
+		return errors.Errorf("expiration time %v of NSE %s is more than %v in the future", nse.GetExpirationTime().AsTime(),
+			nse.GetName(), r.MaxExpiration)
+	}
+	for service, labels := range nse.GetNetworkServiceLabels() {
+		if err := r.labels(labels.GetLabels()); err != nil {
+			return errors.Wrapf(err, "invalid labels of network service %s of NSE %s", service, nse.GetName())
+		}
+	}
+	return nil
+}
+
+// ns returns an error if the NS breaks the rules
+func (r *Rules) ns(ns *registry.NetworkService) error {
+	if err := name("NS", ns.GetName()); err != nil {
+		return err
+	}
+	for _, match := range ns.GetMatches() {
+		if err := r.labels(match.GetSourceSelector()); err != nil {
+			return errors.Wrapf(err, "invalid source selector of NS %s", ns.GetName())
+		}
+		for _, route := range match.GetRoutes() {
+			if err := r.labels(route.GetDestinationSelector()); err != nil {
+				return errors.Wrapf(err, "invalid destination selector of NS %s", ns.GetName())
+			}
+		}
+	}
+	return nil
+}
+
+func (r *Rules) labels(labels map[string]string) error {
+	if r.MaxLabels > 0 && len(labels) > r.MaxLabels {
+		return errors.Errorf("%d labels exceed the limit of %d", len(labels), r.MaxLabels)
+	}
+	if r.MaxLabelLength <= 0 {
+		return nil
+	}
+	for key, value := range labels {
+		if len(key) > r.MaxLabelLength || len(value) > r.MaxLabelLength {
+			return errors.Errorf("label %.32q exceeds the length limit of %d", key, r.MaxLabelLength)
+		}
+	}
+	return nil
+}
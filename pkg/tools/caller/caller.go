@@ -0,0 +1,80 @@
+// Copyright (c) 2026 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package caller provides the SPIFFE IDs of the client a registry request originates from and of the peer sending it
+package caller
+
+import (
+	"context"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/spiffe/go-spiffe/v2/spiffeid"
+	"github.com/spiffe/go-spiffe/v2/svid/x509svid"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+
+	"github.com/networkservicemesh/sdk/pkg/registry/common/grpcmetadata"
+)
+
+// FromContext returns the SPIFFE ID from the token of the first path segment. The token has already been validated by
+// the authorization policies, so it is parsed without verification. The chain element calling it must follow the path
+// update and authorization.
+func FromContext(ctx context.Context) string {
+	path := grpcmetadata.PathFromContext(ctx)
+	if len(path.PathSegments) == 0 {
+		return ""
+	}
+	claims := jwt.RegisteredClaims{}
+	if _, _, err := jwt.NewParser().ParseUnverified(path.PathSegments[0].Token, &claims); err != nil {
+		return ""
+	}
+	return claims.Subject
+}
+
+// Namespace returns the Kubernetes namespace of the SPIFFE ID in the spiffe://<trust domain>/ns/<namespace>/sa/<service
+// account> form, or an empty string if the SPIFFE ID has no namespace
+func Namespace(spiffeID string) string {
+	id, err := spiffeid.FromString(spiffeID)
+	if err != nil {
+		return ""
+	}
+	segments := strings.Split(strings.TrimPrefix(id.Path(), "/"), "/")
+	for i := 0; i+1 < len(segments); i++ {
+		if segments[i] == "ns" {
+			return segments[i+1]
+		}
+	}
+	return ""
+}
+
+// PeerFromContext returns the SPIFFE ID of the mTLS certificate of the gRPC peer, e.g. the NSMgr forwarding the request,
+// or an empty string if the peer has no SPIFFE ID
+func PeerFromContext(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return ""
+	}
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(tlsInfo.State.PeerCertificates) == 0 {
+		return ""
+	}
+	id, err := x509svid.IDFromCert(tlsInfo.State.PeerCertificates[0])
+	if err != nil {
+		return ""
+	}
+	return id.String()
+}
@@ -0,0 +1,97 @@
+// Copyright (c) 2026 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package applyupdate provides a clientset that writes NSE and NS updates with server-side apply
+package applyupdate
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	v1 "github.com/networkservicemesh/sdk-k8s/pkg/tools/k8s/apis/networkservicemesh.io/v1"
+	"github.com/networkservicemesh/sdk-k8s/pkg/tools/k8s/client/clientset/versioned"
+	nsmv1 "github.com/networkservicemesh/sdk-k8s/pkg/tools/k8s/client/clientset/versioned/typed/networkservicemesh.io/v1"
+
+	"github.com/networkservicemesh/cmd-registry-k8s/pkg/tools/clientset"
+)
+
+// NewClientSet returns the client that replaces NSE and NS updates with server-side apply patches of their specs. The
+// patches have no ResourceVersion precondition, so concurrent refreshes of the same object don't conflict. The field
+// manager is required for the patches, so client is expected to set it.
+func NewClientSet(client versioned.Interface) versioned.Interface {
+	return clientset.New(client,
+		clientset.WithNetworkServiceEndpoints(func(_ string, c nsmv1.NetworkServiceEndpointInterface) nsmv1.NetworkServiceEndpointInterface {
+			return &nseClient{
+				NetworkServiceEndpointInterface: c,
+			}
+		}),
+		clientset.WithNetworkServices(func(_ string, c nsmv1.NetworkServiceInterface) nsmv1.NetworkServiceInterface {
+			return &nsClient{
+				NetworkServiceInterface: c,
+			}
+		}),
+	)
+}
+
+type nseClient struct {
+	nsmv1.NetworkServiceEndpointInterface
+}
+
+func (c *nseClient) Update(ctx context.Context, nse *v1.NetworkServiceEndpoint, opts metav1.UpdateOptions) (*v1.NetworkServiceEndpoint, error) {
+	data, err := applyConfiguration("NetworkServiceEndpoint", nse.GetName(), &nse.Spec)
+	if err != nil {
+		return nil, err
+	}
+	return c.Patch(ctx, nse.GetName(), types.ApplyPatchType, data, patchOptions(opts))
+}
+
+type nsClient struct {
+	nsmv1.NetworkServiceInterface
+}
+
+func (c *nsClient) Update(ctx context.Context, ns *v1.NetworkService, opts metav1.UpdateOptions) (*v1.NetworkService, error) {
+	data, err := applyConfiguration("NetworkService", ns.GetName(), &ns.Spec)
+	if err != nil {
+		return nil, err
+	}
+	return c.Patch(ctx, ns.GetName(), types.ApplyPatchType, data, patchOptions(opts))
+}
+
+// applyConfiguration returns the apply patch setting the spec of the object. Metadata other than the name is not
+// included, so the registry doesn't take over labels and annotations of other field managers.
+func applyConfiguration(kind, name string, spec interface{}) ([]byte, error) {
+	data, err := json.Marshal(map[string]interface{}{
+		"apiVersion": v1.SchemeGroupVersion.String(),
+		"kind":       kind,
+		"metadata": map[string]interface{}{
+			"name": name,
+		},
+		"spec": spec,
+	})
+	return data, errors.Wrapf(err, "failed to create an apply patch for %s %s", kind, name)
+}
+
+func patchOptions(opts metav1.UpdateOptions) metav1.PatchOptions {
+	return metav1.PatchOptions{
+		DryRun:          opts.DryRun,
+		FieldManager:    opts.FieldManager,
+		FieldValidation: opts.FieldValidation,
+	}
+}
@@ -0,0 +1,138 @@
+// Copyright (c) 2026 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package circuitbreaker
+
+import (
+	"context"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+
+	"github.com/networkservicemesh/sdk/pkg/tools/clock"
+	"github.com/networkservicemesh/sdk/pkg/tools/log"
+)
+
+// breaker tracks consecutive API server failures. After failureThreshold failures it opens and rejects calls for
+// openTimeout, then lets a single probe call through. A failed probe doubles the time the breaker stays open up to
+// maxOpenTimeout, a successful one closes the breaker.
+type breaker struct {
+	ctx              context.Context
+	failureThreshold int
+	openTimeout      time.Duration
+	maxOpenTimeout   time.Duration
+
+	mu        sync.Mutex
+	failures  int
+	open      bool
+	probing   bool
+	openUntil time.Time
+	backoff   time.Duration
+}
+
+// call calls fn unless the breaker is open and records its result
+func (b *breaker) call(ctx context.Context, fn func() error) error {
+	probe, err := b.allow(ctx)
+	if err != nil {
+		return err
+	}
+	err = fn()
+	b.done(probe, err)
+	return err
+}
+
+// allow returns the Unavailable status error if the call is rejected, and whether the call probes the API server
+func (b *breaker) allow(ctx context.Context) (probe bool, err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if !b.open {
+		return false, nil
+	}
+	if remaining := clock.FromContext(b.ctx).Until(b.openUntil); b.probing || remaining > 0 {
+		if rejectedCalls != nil {
+			rejectedCalls.Add(ctx, 1)
+		}
+		return false, status.Errorf(codes.Unavailable, "Kubernetes API server is unavailable, retry in %v", remaining.Round(time.Millisecond))
+	}
+	b.probing = true
+	return true, nil
+}
+
+func (b *breaker) done(probe bool, err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	logger := log.FromContext(b.ctx).WithField("circuitBreaker", "done")
+	switch {
+	case errors.Is(err, context.Canceled):
+		// The caller has gone, the call says nothing about the API server
+		if probe {
+			b.probing = false
+		}
+	case !failed(err):
+		if b.open {
+			logger.Infof("Kubernetes API server has recovered, closing the circuit")
+		}
+		b.failures = 0
+		b.open = false
+		b.probing = false
+	case b.open:
+		// Calls started before the breaker has opened don't change the backoff
+		if !probe {
+			return
+		}
+		b.probing = false
+		b.backoff *= 2
+		if b.backoff > b.maxOpenTimeout {
+			b.backoff = b.maxOpenTimeout
+		}
+		b.openUntil = clock.FromContext(b.ctx).Now().Add(b.backoff)
+		logger.Warnf("Kubernetes API server is still unavailable, next probe in %v: %v", b.backoff, err.Error())
+	default:
+		b.failures++
+		if b.failures < b.failureThreshold {
+			return
+		}
+		b.open = true
+		b.backoff = b.openTimeout
+		b.openUntil = clock.FromContext(b.ctx).Now().Add(b.backoff)
+		logger.Warnf("Kubernetes API server has failed %d times in a row, opening the circuit for %v: %v", b.failures, b.backoff, err.Error())
+		if opened != nil {
+			opened.Add(b.ctx, 1)
+		}
+	}
+}
+
+// failed returns true if the error shows that the API server is overloaded or unreachable
+func failed(err error) bool {
+	if err == nil {
+		return false
+	}
+	var netErr net.Error
+	return apierrors.IsServerTimeout(err) ||
+		apierrors.IsTimeout(err) ||
+		apierrors.IsTooManyRequests(err) ||
+		apierrors.IsServiceUnavailable(err) ||
+		apierrors.IsInternalError(err) ||
+		errors.Is(err, context.DeadlineExceeded) ||
+		errors.As(err, &netErr)
+}
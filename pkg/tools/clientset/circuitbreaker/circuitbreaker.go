@@ -0,0 +1,180 @@
+// Copyright (c) 2026 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package circuitbreaker provides a clientset failing NSE and NS API calls fast while the Kubernetes API server is
+// overloaded or unreachable
+package circuitbreaker
+
+import (
+	"context"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
+
+	v1 "github.com/networkservicemesh/sdk-k8s/pkg/tools/k8s/apis/networkservicemesh.io/v1"
+	"github.com/networkservicemesh/sdk-k8s/pkg/tools/k8s/client/clientset/versioned"
+	nsmv1 "github.com/networkservicemesh/sdk-k8s/pkg/tools/k8s/client/clientset/versioned/typed/networkservicemesh.io/v1"
+
+	"github.com/networkservicemesh/cmd-registry-k8s/pkg/tools/clientset"
+)
+
+// NewClientSet returns the client that opens the circuit after failureThreshold consecutive NSE and NS calls have
+// failed with timeouts, throttling, server or network errors. While the circuit is open, calls fail immediately with
+// a retryable Unavailable gRPC status. After openTimeout a single call probes the API server: if it succeeds, the
+// circuit is closed, otherwise it stays open twice as long, up to maxOpenTimeout. The clock and the logger are taken
+// from ctx.
+func NewClientSet(ctx context.Context, client versioned.Interface, failureThreshold int, openTimeout, maxOpenTimeout time.Duration) versioned.Interface {
+	b := &breaker{
+		ctx:              ctx,
+		failureThreshold: failureThreshold,
+		openTimeout:      openTimeout,
+		maxOpenTimeout:   maxOpenTimeout,
+	}
+	return clientset.New(client,
+		clientset.WithNetworkServiceEndpoints(func(_ string, c nsmv1.NetworkServiceEndpointInterface) nsmv1.NetworkServiceEndpointInterface {
+			return &nseClient{
+				NetworkServiceEndpointInterface: c,
+				breaker:                         b,
+			}
+		}),
+		clientset.WithNetworkServices(func(_ string, c nsmv1.NetworkServiceInterface) nsmv1.NetworkServiceInterface {
+			return &nsClient{
+				NetworkServiceInterface: c,
+				breaker:                 b,
+			}
+		}),
+	)
+}
+
+type nseClient struct {
+	nsmv1.NetworkServiceEndpointInterface
+	breaker *breaker
+}
+
+func (c *nseClient) Get(ctx context.Context, name string, opts metav1.GetOptions) (result *v1.NetworkServiceEndpoint, err error) {
+	err = c.breaker.call(ctx, func() error {
+		result, err = c.NetworkServiceEndpointInterface.Get(ctx, name, opts)
+		return err
+	})
+	return result, err
+}
+
+func (c *nseClient) List(ctx context.Context, opts metav1.ListOptions) (result *v1.NetworkServiceEndpointList, err error) {
+	err = c.breaker.call(ctx, func() error {
+		result, err = c.NetworkServiceEndpointInterface.List(ctx, opts)
+		return err
+	})
+	return result, err
+}
+
+func (c *nseClient) Watch(ctx context.Context, opts metav1.ListOptions) (result watch.Interface, err error) {
+	err = c.breaker.call(ctx, func() error {
+		result, err = c.NetworkServiceEndpointInterface.Watch(ctx, opts)
+		return err
+	})
+	return result, err
+}
+
+func (c *nseClient) Create(ctx context.Context, nse *v1.NetworkServiceEndpoint, opts metav1.CreateOptions) (result *v1.NetworkServiceEndpoint, err error) {
+	err = c.breaker.call(ctx, func() error {
+		result, err = c.NetworkServiceEndpointInterface.Create(ctx, nse, opts)
+		return err
+	})
+	return result, err
+}
+
+func (c *nseClient) Update(ctx context.Context, nse *v1.NetworkServiceEndpoint, opts metav1.UpdateOptions) (result *v1.NetworkServiceEndpoint, err error) {
+	err = c.breaker.call(ctx, func() error {
+		result, err = c.NetworkServiceEndpointInterface.Update(ctx, nse, opts)
+		return err
+	})
+	return result, err
+}
+
+func (c *nseClient) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions, subresources ...string) (result *v1.NetworkServiceEndpoint, err error) {
+	err = c.breaker.call(ctx, func() error {
+		result, err = c.NetworkServiceEndpointInterface.Patch(ctx, name, pt, data, opts, subresources...)
+		return err
+	})
+	return result, err
+}
+
+func (c *nseClient) Delete(ctx context.Context, name string, opts metav1.DeleteOptions) error {
+	return c.breaker.call(ctx, func() error {
+		return c.NetworkServiceEndpointInterface.Delete(ctx, name, opts)
+	})
+}
+
+type nsClient struct {
+	nsmv1.NetworkServiceInterface
+	breaker *breaker
+}
+
+func (c *nsClient) Get(ctx context.Context, name string, opts metav1.GetOptions) (result *v1.NetworkService, err error) {
+	err = c.breaker.call(ctx, func() error {
+		result, err = c.NetworkServiceInterface.Get(ctx, name, opts)
+		return err
+	})
+	return result, err
+}
+
+func (c *nsClient) List(ctx context.Context, opts metav1.ListOptions) (result *v1.NetworkServiceList, err error) {
+	err = c.breaker.call(ctx, func() error {
+		result, err = c.NetworkServiceInterface.List(ctx, opts)
+		return err
+	})
+	return result, err
+}
+
+func (c *nsClient) Watch(ctx context.Context, opts metav1.ListOptions) (result watch.Interface, err error) {
+	err = c.breaker.call(ctx, func() error {
+		result, err = c.NetworkServiceInterface.Watch(ctx, opts)
+		return err
+	})
+	return result, err
+}
+
+func (c *nsClient) Create(ctx context.Context, ns *v1.NetworkService, opts metav1.CreateOptions) (result *v1.NetworkService, err error) {
+	err = c.breaker.call(ctx, func() error {
+		result, err = c.NetworkServiceInterface.Create(ctx, ns, opts)
+		return err
+	})
+	return result, err
+}
+
+func (c *nsClient) Update(ctx context.Context, ns *v1.NetworkService, opts metav1.UpdateOptions) (result *v1.NetworkService, err error) {
+	err = c.breaker.call(ctx, func() error {
+		result, err = c.NetworkServiceInterface.Update(ctx, ns, opts)
+		return err
+	})
+	return result, err
+}
+
+func (c *nsClient) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions, subresources ...string) (result *v1.NetworkService, err error) {
+	err = c.breaker.call(ctx, func() error {
+		result, err = c.NetworkServiceInterface.Patch(ctx, name, pt, data, opts, subresources...)
+		return err
+	})
+	return result, err
+}
+
+func (c *nsClient) Delete(ctx context.Context, name string, opts metav1.DeleteOptions) error {
+	return c.breaker.call(ctx, func() error {
+		return c.NetworkServiceInterface.Delete(ctx, name, opts)
+	})
+}
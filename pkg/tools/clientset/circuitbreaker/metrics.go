@@ -0,0 +1,54 @@
+// Copyright (c) 2026 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package circuitbreaker
+
+import (
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/metric"
+)
+
+const (
+	meterName                = "github.com/networkservicemesh/cmd-registry-k8s/pkg/tools/clientset/circuitbreaker"
+	openedCounterName        = "registry_k8s_circuit_breaker_opened_total"
+	rejectedCallsCounterName = "registry_k8s_circuit_breaker_rejected_calls_total"
+)
+
+var (
+	// opened counts the times the circuit breaker has opened
+	opened metric.Int64Counter
+	// rejectedCalls counts NSE and NS API calls failed fast by the open circuit breaker
+	rejectedCalls metric.Int64Counter
+)
+
+func init() {
+	var err error
+	meter := otel.Meter(meterName)
+	opened, err = meter.Int64Counter(
+		openedCounterName,
+		metric.WithDescription("Number of times the Kubernetes API circuit breaker has opened"),
+	)
+	if err != nil {
+		otel.Handle(err)
+	}
+	rejectedCalls, err = meter.Int64Counter(
+		rejectedCallsCounterName,
+		metric.WithDescription("Number of Kubernetes API calls rejected by the open circuit breaker"),
+	)
+	if err != nil {
+		otel.Handle(err)
+	}
+}
@@ -0,0 +1,97 @@
+// Copyright (c) 2026 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package clientset provides decorators for the networkservicemesh.io clientset used by the registry chain
+package clientset
+
+import (
+	nsmv1 "github.com/networkservicemesh/sdk-k8s/pkg/tools/k8s/client/clientset/versioned/typed/networkservicemesh.io/v1"
+
+	"github.com/networkservicemesh/sdk-k8s/pkg/tools/k8s/client/clientset/versioned"
+)
+
+// NetworkServiceEndpointsDecorator decorates the NetworkServiceEndpoints client for the namespace
+type NetworkServiceEndpointsDecorator func(namespace string, client nsmv1.NetworkServiceEndpointInterface) nsmv1.NetworkServiceEndpointInterface
+
+// NetworkServicesDecorator decorates the NetworkServices client for the namespace
+type NetworkServicesDecorator func(namespace string, client nsmv1.NetworkServiceInterface) nsmv1.NetworkServiceInterface
+
+type options struct {
+	nseDecorator NetworkServiceEndpointsDecorator
+	nsDecorator  NetworkServicesDecorator
+}
+
+// Option is an option pattern for New
+type Option func(o *options)
+
+// WithNetworkServiceEndpoints sets the decorator for the NetworkServiceEndpoints clients
+func WithNetworkServiceEndpoints(decorator NetworkServiceEndpointsDecorator) Option {
+	return func(o *options) {
+		o.nseDecorator = decorator
+	}
+}
+
+// WithNetworkServices sets the decorator for the NetworkServices clients
+func WithNetworkServices(decorator NetworkServicesDecorator) Option {
+	return func(o *options) {
+		o.nsDecorator = decorator
+	}
+}
+
+type clientSet struct {
+	versioned.Interface
+	opts *options
+}
+
+// New returns the client with the NetworkServiceEndpoints and NetworkServices clients decorated
+func New(client versioned.Interface, opts ...Option) versioned.Interface {
+	o := &options{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return &clientSet{
+		Interface: client,
+		opts:      o,
+	}
+}
+
+func (c *clientSet) NetworkservicemeshV1() nsmv1.NetworkservicemeshV1Interface {
+	return &networkservicemeshV1{
+		NetworkservicemeshV1Interface: c.Interface.NetworkservicemeshV1(),
+		opts:                          c.opts,
+	}
+}
+
+type networkservicemeshV1 struct {
+	nsmv1.NetworkservicemeshV1Interface
+	opts *options
+}
+
+func (c *networkservicemeshV1) NetworkServiceEndpoints(namespace string) nsmv1.NetworkServiceEndpointInterface {
+	client := c.NetworkservicemeshV1Interface.NetworkServiceEndpoints(namespace)
+	if c.opts.nseDecorator == nil {
+		return client
+	}
+	return c.opts.nseDecorator(namespace, client)
+}
+
+func (c *networkservicemeshV1) NetworkServices(namespace string) nsmv1.NetworkServiceInterface {
+	client := c.NetworkservicemeshV1Interface.NetworkServices(namespace)
+	if c.opts.nsDecorator == nil {
+		return client
+	}
+	return c.opts.nsDecorator(namespace, client)
+}
@@ -0,0 +1,134 @@
+// Copyright (c) 2026 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package clienttiming provides a clientset recording the latency of NSE and NS API calls as chain element latencies
+package clienttiming
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	v1 "github.com/networkservicemesh/sdk-k8s/pkg/tools/k8s/apis/networkservicemesh.io/v1"
+	"github.com/networkservicemesh/sdk-k8s/pkg/tools/k8s/client/clientset/versioned"
+	nsmv1 "github.com/networkservicemesh/sdk-k8s/pkg/tools/k8s/client/clientset/versioned/typed/networkservicemesh.io/v1"
+
+	"github.com/networkservicemesh/cmd-registry-k8s/pkg/registry/common/timing"
+	"github.com/networkservicemesh/cmd-registry-k8s/pkg/tools/clientset"
+)
+
+const (
+	nseElement = "k8sNSEClient"
+	nsElement  = "k8sNSClient"
+)
+
+// NewClientSet returns the client recording the latency of NSE and NS Get, List, Create, Update, Patch and Delete
+// calls with timing.Start, so the time spent in the API server is separated from the chain elements calling it
+func NewClientSet(client versioned.Interface) versioned.Interface {
+	return clientset.New(client,
+		clientset.WithNetworkServiceEndpoints(func(_ string, c nsmv1.NetworkServiceEndpointInterface) nsmv1.NetworkServiceEndpointInterface {
+			return &nseClient{
+				NetworkServiceEndpointInterface: c,
+			}
+		}),
+		clientset.WithNetworkServices(func(_ string, c nsmv1.NetworkServiceInterface) nsmv1.NetworkServiceInterface {
+			return &nsClient{
+				NetworkServiceInterface: c,
+			}
+		}),
+	)
+}
+
+type nseClient struct {
+	nsmv1.NetworkServiceEndpointInterface
+}
+
+func (c *nseClient) Get(ctx context.Context, name string, opts metav1.GetOptions) (*v1.NetworkServiceEndpoint, error) {
+	ctx, done := timing.Start(ctx, nseElement, "Get")
+	defer done()
+	return c.NetworkServiceEndpointInterface.Get(ctx, name, opts)
+}
+
+func (c *nseClient) List(ctx context.Context, opts metav1.ListOptions) (*v1.NetworkServiceEndpointList, error) {
+	ctx, done := timing.Start(ctx, nseElement, "List")
+	defer done()
+	return c.NetworkServiceEndpointInterface.List(ctx, opts)
+}
+
+func (c *nseClient) Create(ctx context.Context, nse *v1.NetworkServiceEndpoint, opts metav1.CreateOptions) (*v1.NetworkServiceEndpoint, error) {
+	ctx, done := timing.Start(ctx, nseElement, "Create")
+	defer done()
+	return c.NetworkServiceEndpointInterface.Create(ctx, nse, opts)
+}
+
+func (c *nseClient) Update(ctx context.Context, nse *v1.NetworkServiceEndpoint, opts metav1.UpdateOptions) (*v1.NetworkServiceEndpoint, error) {
+	ctx, done := timing.Start(ctx, nseElement, "Update")
+	defer done()
+	return c.NetworkServiceEndpointInterface.Update(ctx, nse, opts)
+}
+
+func (c *nseClient) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions, subresources ...string) (*v1.NetworkServiceEndpoint, error) {
+	ctx, done := timing.Start(ctx, nseElement, "Patch")
+	defer done()
+	return c.NetworkServiceEndpointInterface.Patch(ctx, name, pt, data, opts, subresources...)
+}
+
+func (c *nseClient) Delete(ctx context.Context, name string, opts metav1.DeleteOptions) error {
+	ctx, done := timing.Start(ctx, nseElement, "Delete")
+	defer done()
+	return c.NetworkServiceEndpointInterface.Delete(ctx, name, opts)
+}
+
+type nsClient struct {
+	nsmv1.NetworkServiceInterface
+}
+
+func (c *nsClient) Get(ctx context.Context, name string, opts metav1.GetOptions) (*v1.NetworkService, error) {
+	ctx, done := timing.Start(ctx, nsElement, "Get")
+	defer done()
+	return c.NetworkServiceInterface.Get(ctx, name, opts)
+}
+
+func (c *nsClient) List(ctx context.Context, opts metav1.ListOptions) (*v1.NetworkServiceList, error) {
+	ctx, done := timing.Start(ctx, nsElement, "List")
+	defer done()
+	return c.NetworkServiceInterface.List(ctx, opts)
+}
+
+func (c *nsClient) Create(ctx context.Context, ns *v1.NetworkService, opts metav1.CreateOptions) (*v1.NetworkService, error) {
+	ctx, done := timing.Start(ctx, nsElement, "Create")
+	defer done()
+	return c.NetworkServiceInterface.Create(ctx, ns, opts)
+}
+
+func (c *nsClient) Update(ctx context.Context, ns *v1.NetworkService, opts metav1.UpdateOptions) (*v1.NetworkService, error) {
+	ctx, done := timing.Start(ctx, nsElement, "Update")
+	defer done()
+	return c.NetworkServiceInterface.Update(ctx, ns, opts)
+}
+
+func (c *nsClient) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions, subresources ...string) (*v1.NetworkService, error) {
+	ctx, done := timing.Start(ctx, nsElement, "Patch")
+	defer done()
+	return c.NetworkServiceInterface.Patch(ctx, name, pt, data, opts, subresources...)
+}
+
+func (c *nsClient) Delete(ctx context.Context, name string, opts metav1.DeleteOptions) error {
+	ctx, done := timing.Start(ctx, nsElement, "Delete")
+	defer done()
+	return c.NetworkServiceInterface.Delete(ctx, name, opts)
+}
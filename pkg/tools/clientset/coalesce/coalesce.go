@@ -0,0 +1,230 @@
+// Copyright (c) 2026 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package coalesce provides a clientset coalescing repeated creates and updates of the same NSE issued within a window
+// into a single API call. Writes of different NSEs are not batched, since the API server has no batch writes.
+package coalesce
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/networkservicemesh/sdk/pkg/tools/clock"
+
+	v1 "github.com/networkservicemesh/sdk-k8s/pkg/tools/k8s/apis/networkservicemesh.io/v1"
+	"github.com/networkservicemesh/sdk-k8s/pkg/tools/k8s/client/clientset/versioned"
+	nsmv1 "github.com/networkservicemesh/sdk-k8s/pkg/tools/k8s/client/clientset/versioned/typed/networkservicemesh.io/v1"
+
+	"github.com/networkservicemesh/cmd-registry-k8s/pkg/tools/clientset"
+)
+
+const (
+	verbCreate = "create"
+	verbUpdate = "update"
+)
+
+// NewClientSet returns the client which delays NSE creates and updates by window. Consecutive writes of the same NSE
+// with the same verb issued within the window are coalesced: only the last of them is sent, with the options and
+// the context values of its caller, and all of them return its result. Writes of the same NSE with different verbs are
+// sent one after another in the order they were issued. The sent write is cancelled only when all of its callers have
+// given up, and its deadline is the latest one of theirs. Registrations repeated by clients and NSMgrs during restart
+// storms cost a single write this way.
+func NewClientSet(client versioned.Interface, window time.Duration) versioned.Interface {
+	c := &coalescer{
+		window: window,
+		queues: make(map[nseKey]*queue),
+	}
+	return clientset.New(client,
+		clientset.WithNetworkServiceEndpoints(func(namespace string, nses nsmv1.NetworkServiceEndpointInterface) nsmv1.NetworkServiceEndpointInterface {
+			return &nseClient{
+				NetworkServiceEndpointInterface: nses,
+				namespace:                       namespace,
+				coalescer:                       c,
+			}
+		}),
+	)
+}
+
+type nseClient struct {
+	nsmv1.NetworkServiceEndpointInterface
+	namespace string
+	*coalescer
+}
+
+func (c *nseClient) Create(ctx context.Context, nse *v1.NetworkServiceEndpoint, opts metav1.CreateOptions) (*v1.NetworkServiceEndpoint, error) {
+	if nse.GetName() == "" {
+		return c.NetworkServiceEndpointInterface.Create(ctx, nse, opts)
+	}
+	return c.do(ctx, nseKey{namespace: c.namespace, name: nse.GetName()}, verbCreate, func(ctx context.Context) (*v1.NetworkServiceEndpoint, error) {
+		return c.NetworkServiceEndpointInterface.Create(ctx, nse, opts)
+	})
+}
+
+func (c *nseClient) Update(ctx context.Context, nse *v1.NetworkServiceEndpoint, opts metav1.UpdateOptions) (*v1.NetworkServiceEndpoint, error) {
+	return c.do(ctx, nseKey{namespace: c.namespace, name: nse.GetName()}, verbUpdate, func(ctx context.Context) (*v1.NetworkServiceEndpoint, error) {
+		return c.NetworkServiceEndpointInterface.Update(ctx, nse, opts)
+	})
+}
+
+type nseKey struct {
+	namespace, name string
+}
+
+// write is a pending write of an NSE, ctx and send are replaced by later writes of the same verb
+type write struct {
+	verb string
+	ctx  context.Context
+	send func(ctx context.Context) (*v1.NetworkServiceEndpoint, error)
+
+	// waiters is the number of callers waiting for the write, deadline is the latest deadline of theirs, or zero if
+	// one of them has none
+	waiters  int
+	deadline time.Time
+	sent     bool
+	cancel   context.CancelFunc
+
+	done chan struct{}
+	resp *v1.NetworkServiceEndpoint
+	err  error
+}
+
+// queue is the writes of an NSE in the order they are sent
+type queue struct {
+	writes []*write
+}
+
+type coalescer struct {
+	window time.Duration
+
+	mu     sync.Mutex
+	queues map[nseKey]*queue
+}
+
+// do queues the write of the NSE with the key, coalescing it with the last queued write if it has the same verb and
+// hasn't been sent yet, and returns the result of the write sent
+func (c *coalescer) do(ctx context.Context, key nseKey, verb string, send func(ctx context.Context) (*v1.NetworkServiceEndpoint, error)) (*v1.NetworkServiceEndpoint, error) {
+	c.mu.Lock()
+	q, ok := c.queues[key]
+	if !ok {
+		q = new(queue)
+		c.queues[key] = q
+		go c.run(key, q, clock.FromContext(ctx))
+	}
+	var w *write
+	if n := len(q.writes); n > 0 && !q.writes[n-1].sent && q.writes[n-1].verb == verb {
+		w = q.writes[n-1]
+		w.ctx, w.send = ctx, send
+		if coalescedWrites != nil {
+			coalescedWrites.Add(ctx, 1)
+		}
+	} else {
+		w = &write{
+			verb: verb,
+			ctx:  ctx,
+			send: send,
+			done: make(chan struct{}),
+		}
+		q.writes = append(q.writes, w)
+	}
+	w.join(ctx)
+	c.mu.Unlock()
+
+	select {
+	case <-ctx.Done():
+		c.leave(w)
+		return nil, ctx.Err()
+	case <-w.done:
+		// Copying initializes the protobuf state of the spec, so the callers of the write don't copy it concurrently
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		return w.resp.DeepCopy(), w.err
+	}
+}
+
+// run sends the queued writes of the NSE with the key, each after the window, until there are none left
+func (c *coalescer) run(key nseKey, q *queue, timeClock clock.Clock) {
+	for {
+		<-timeClock.After(c.window)
+
+		c.mu.Lock()
+		w := q.writes[0]
+		q.writes = q.writes[1:]
+		w.sent = true
+		ctx, cancel := w.sendContext()
+		w.cancel = cancel
+		send := w.send
+		c.mu.Unlock()
+
+		if ctx.Err() == nil {
+			w.resp, w.err = send(ctx)
+		} else {
+			w.err = ctx.Err()
+		}
+		cancel()
+		close(w.done)
+
+		c.mu.Lock()
+		if len(q.writes) == 0 {
+			delete(c.queues, key)
+			c.mu.Unlock()
+			return
+		}
+		c.mu.Unlock()
+	}
+}
+
+// leave removes a caller which has given up waiting for the write, and cancels the write if it was the last one
+func (c *coalescer) leave(w *write) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	w.waiters--
+	if w.waiters == 0 && w.cancel != nil {
+		w.cancel()
+	}
+}
+
+// join adds the caller with the context to the waiters of the write
+func (w *write) join(ctx context.Context) {
+	deadline, ok := ctx.Deadline()
+	switch {
+	case !ok:
+		w.deadline = time.Time{}
+	case w.waiters == 0, !w.deadline.IsZero() && deadline.After(w.deadline):
+		w.deadline = deadline
+	}
+	w.waiters++
+}
+
+// sendContext returns the context of the write to send: it keeps the values of the last caller but is cancelled only
+// when all callers have given up or the latest deadline has passed
+func (w *write) sendContext() (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(context.WithoutCancel(w.ctx))
+	if w.waiters == 0 {
+		cancel()
+		return ctx, cancel
+	}
+	if w.deadline.IsZero() {
+		return ctx, cancel
+	}
+	deadlineCtx, cancelDeadline := context.WithDeadline(ctx, w.deadline)
+	return deadlineCtx, func() {
+		cancelDeadline()
+		cancel()
+	}
+}
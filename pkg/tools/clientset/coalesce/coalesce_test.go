@@ -0,0 +1,126 @@
+// Copyright (c) 2026 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package coalesce
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "github.com/networkservicemesh/sdk-k8s/pkg/tools/k8s/apis/networkservicemesh.io/v1"
+	"github.com/networkservicemesh/sdk-k8s/pkg/tools/k8s/client/clientset/versioned/fake"
+	nsmv1 "github.com/networkservicemesh/sdk-k8s/pkg/tools/k8s/client/clientset/versioned/typed/networkservicemesh.io/v1"
+
+	"github.com/networkservicemesh/cmd-registry-k8s/pkg/tools/clientset"
+)
+
+const window = 50 * time.Millisecond
+
+// barrierNSEs holds each NSE update until all expected updates are in flight, or the timeout expires
+type barrierNSEs struct {
+	nsmv1.NetworkServiceEndpointInterface
+	updates  atomic.Int32
+	expected int32
+	all      chan struct{}
+	timeout  time.Duration
+}
+
+func (b *barrierNSEs) Update(_ context.Context, nse *v1.NetworkServiceEndpoint, _ metav1.UpdateOptions) (*v1.NetworkServiceEndpoint, error) {
+	if b.updates.Add(1) == b.expected {
+		close(b.all)
+	}
+	select {
+	case <-b.all:
+		return nse, nil
+	case <-time.After(b.timeout):
+		return nil, fmt.Errorf("update of %s is not sent concurrently with the other ones", nse.GetName())
+	}
+}
+
+func newNSE(name string) *v1.NetworkServiceEndpoint {
+	return &v1.NetworkServiceEndpoint{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "ns"}}
+}
+
+func TestNSEClient_UpdateDistinctNSEs(t *testing.T) {
+	const count = 10
+	barrier := &barrierNSEs{expected: count, all: make(chan struct{}), timeout: time.Second}
+	client := NewClientSet(clientset.New(fake.NewSimpleClientset(),
+		clientset.WithNetworkServiceEndpoints(func(_ string, c nsmv1.NetworkServiceEndpointInterface) nsmv1.NetworkServiceEndpointInterface {
+			barrier.NetworkServiceEndpointInterface = c
+			return barrier
+		}),
+	), window)
+	nses := client.NetworkservicemeshV1().NetworkServiceEndpoints("ns")
+
+	start := time.Now()
+	var wg sync.WaitGroup
+	errs := make(chan error, count)
+	for i := 0; i < count; i++ {
+		wg.Add(1)
+		go func(name string) {
+			defer wg.Done()
+			if _, err := nses.Update(context.Background(), newNSE(name), metav1.UpdateOptions{}); err != nil {
+				errs <- err
+			}
+		}(fmt.Sprintf("nse-%d", i))
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		t.Fatal(err)
+	}
+	if got := barrier.updates.Load(); got != count {
+		t.Fatalf("%d updates sent, want one for each of the %d NSEs", got, count)
+	}
+	// Each NSE waits for its own window only, not for the windows of the other NSEs
+	if elapsed := time.Since(start); elapsed > count*window/2 {
+		t.Fatalf("updates of %d NSEs took %v with a window of %v", count, elapsed, window)
+	}
+}
+
+func TestNSEClient_UpdateSameNSE(t *testing.T) {
+	const count = 10
+	barrier := &barrierNSEs{expected: 1, all: make(chan struct{}), timeout: time.Second}
+	client := NewClientSet(clientset.New(fake.NewSimpleClientset(),
+		clientset.WithNetworkServiceEndpoints(func(_ string, c nsmv1.NetworkServiceEndpointInterface) nsmv1.NetworkServiceEndpointInterface {
+			barrier.NetworkServiceEndpointInterface = c
+			return barrier
+		}),
+	), window)
+	nses := client.NetworkservicemeshV1().NetworkServiceEndpoints("ns")
+
+	var wg sync.WaitGroup
+	for i := 0; i < count; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := nses.Update(context.Background(), newNSE("nse"), metav1.UpdateOptions{}); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+	// Updates issued after the first one is sent form another write
+	if got := barrier.updates.Load(); got < 1 || got > 2 {
+		t.Fatalf("%d updates sent for %d coalesced ones", got, count)
+	}
+}
@@ -0,0 +1,41 @@
+// Copyright (c) 2026 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package coalesce
+
+import (
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/metric"
+)
+
+const (
+	meterName                  = "github.com/networkservicemesh/cmd-registry-k8s/pkg/tools/clientset/coalesce"
+	coalescedWritesCounterName = "registry_k8s_coalesced_writes_total"
+)
+
+// coalescedWrites counts NSE creates and updates replaced by a later write of the same NSE
+var coalescedWrites metric.Int64Counter
+
+func init() {
+	var err error
+	coalescedWrites, err = otel.Meter(meterName).Int64Counter(
+		coalescedWritesCounterName,
+		metric.WithDescription("Number of NSE creates and updates coalesced into a later write of the same NSE"),
+	)
+	if err != nil {
+		otel.Handle(err)
+	}
+}
@@ -0,0 +1,98 @@
+// Copyright (c) 2026 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package conflictretry provides a clientset that retries NSE deletes rejected because of a ResourceVersion conflict
+package conflictretry
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/networkservicemesh/sdk/pkg/tools/clock"
+	"github.com/networkservicemesh/sdk/pkg/tools/log"
+
+	"github.com/networkservicemesh/sdk-k8s/pkg/tools/k8s/client/clientset/versioned"
+	nsmv1 "github.com/networkservicemesh/sdk-k8s/pkg/tools/k8s/client/clientset/versioned/typed/networkservicemesh.io/v1"
+
+	"github.com/networkservicemesh/cmd-registry-k8s/pkg/tools/clientset"
+	"github.com/networkservicemesh/cmd-registry-k8s/pkg/tools/expiration"
+	"github.com/networkservicemesh/cmd-registry-k8s/pkg/tools/retrypolicy"
+)
+
+// NewClientSet returns the client that retries NSE deletes with a ResourceVersion precondition failed because of
+// a conflict. On each retry the NSE is fetched again: if it is gone, the delete is done; if it has been refreshed and is
+// not expired anymore according to the mode, it is kept. Otherwise it is deleted with the fresh ResourceVersion. Retries
+// are delayed according to the policy.
+func NewClientSet(client versioned.Interface, policy *retrypolicy.Policy, mode expiration.Mode) versioned.Interface {
+	return clientset.New(client,
+		clientset.WithNetworkServiceEndpoints(func(_ string, c nsmv1.NetworkServiceEndpointInterface) nsmv1.NetworkServiceEndpointInterface {
+			return &nseClient{
+				NetworkServiceEndpointInterface: c,
+				policy:                          policy,
+				mode:                            mode,
+			}
+		}),
+	)
+}
+
+type nseClient struct {
+	nsmv1.NetworkServiceEndpointInterface
+	policy *retrypolicy.Policy
+	mode   expiration.Mode
+}
+
+func (c *nseClient) Delete(ctx context.Context, name string, opts metav1.DeleteOptions) error {
+	err := c.NetworkServiceEndpointInterface.Delete(ctx, name, opts)
+	if !apierrors.IsConflict(err) || opts.Preconditions == nil || opts.Preconditions.ResourceVersion == nil {
+		return err
+	}
+
+	logger := log.FromContext(ctx).WithField("conflictretry", "Delete")
+	return c.policy.Retry(ctx, apierrors.IsConflict, func() error {
+		if conflictRetries != nil {
+			conflictRetries.Add(ctx, 1)
+		}
+		nse, err := c.Get(ctx, name, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if !c.expired(ctx, nse.Spec.ExpirationTime) {
+			logger.Debugf("NSE %s has been refreshed, keeping it", name)
+			return nil
+		}
+
+		opts.Preconditions = &metav1.Preconditions{
+			UID:             opts.Preconditions.UID,
+			ResourceVersion: &nse.ResourceVersion,
+		}
+		if err := c.NetworkServiceEndpointInterface.Delete(ctx, name, opts); err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+		return nil
+	})
+}
+
+// expired returns true if the NSE with the expiration time is expired according to the mode
+func (c *nseClient) expired(ctx context.Context, expirationTime *timestamppb.Timestamp) bool {
+	expiresAt, ok, _ := c.mode.ExpirationTime(expirationTime)
+	return ok && !expiresAt.After(clock.FromContext(ctx).Now())
+}
@@ -0,0 +1,161 @@
+// Copyright (c) 2026 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conflictretry
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	v1 "github.com/networkservicemesh/sdk-k8s/pkg/tools/k8s/apis/networkservicemesh.io/v1"
+	nsmv1 "github.com/networkservicemesh/sdk-k8s/pkg/tools/k8s/client/clientset/versioned/typed/networkservicemesh.io/v1"
+
+	"github.com/networkservicemesh/cmd-registry-k8s/pkg/tools/expiration"
+	"github.com/networkservicemesh/cmd-registry-k8s/pkg/tools/retrypolicy"
+)
+
+// nseStore holds a single NSE and fails the deletes with a stale ResourceVersion with a conflict
+type nseStore struct {
+	nsmv1.NetworkServiceEndpointInterface
+	nse     *v1.NetworkServiceEndpoint
+	deletes []string
+}
+
+func (s *nseStore) Get(_ context.Context, name string, _ metav1.GetOptions) (*v1.NetworkServiceEndpoint, error) {
+	if s.nse == nil {
+		return nil, apierrors.NewNotFound(schema.GroupResource{Resource: "networkserviceendpoints"}, name)
+	}
+	return s.nse.DeepCopy(), nil
+}
+
+func (s *nseStore) Delete(_ context.Context, name string, opts metav1.DeleteOptions) error {
+	var version string
+	if opts.Preconditions != nil && opts.Preconditions.ResourceVersion != nil {
+		version = *opts.Preconditions.ResourceVersion
+	}
+	s.deletes = append(s.deletes, version)
+	if s.nse == nil {
+		return apierrors.NewNotFound(schema.GroupResource{Resource: "networkserviceendpoints"}, name)
+	}
+	if version != "" && version != s.nse.ResourceVersion {
+		return apierrors.NewConflict(schema.GroupResource{Resource: "networkserviceendpoints"}, name, nil)
+	}
+	s.nse = nil
+	return nil
+}
+
+func TestNSEClient_DeleteConflict(t *testing.T) {
+	now := time.Now()
+	for _, tc := range []struct {
+		name           string
+		mode           expiration.Mode
+		expirationTime *timestamppb.Timestamp
+		deleted        bool
+	}{
+		{name: "refreshed", mode: expiration.Lenient, expirationTime: timestamppb.New(now.Add(time.Minute))},
+		{name: "expired", mode: expiration.Lenient, expirationTime: timestamppb.New(now.Add(-time.Minute)), deleted: true},
+		{name: "no expiration", mode: expiration.Lenient},
+		{name: "zero expiration lenient", mode: expiration.Lenient, expirationTime: new(timestamppb.Timestamp), deleted: true},
+		{name: "zero expiration strict", mode: expiration.Strict, expirationTime: new(timestamppb.Timestamp)},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			store := &nseStore{nse: &v1.NetworkServiceEndpoint{
+				ObjectMeta: metav1.ObjectMeta{Name: "nse", ResourceVersion: "2"},
+				Spec:       v1.NetworkServiceEndpointSpec{ExpirationTime: tc.expirationTime},
+			}}
+			c := &nseClient{
+				NetworkServiceEndpointInterface: store,
+				policy:                          &retrypolicy.Default,
+				mode:                            tc.mode,
+			}
+
+			stale := "1"
+			err := c.Delete(context.Background(), "nse", metav1.DeleteOptions{
+				Preconditions: &metav1.Preconditions{ResourceVersion: &stale},
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if deleted := store.nse == nil; deleted != tc.deleted {
+				t.Fatalf("NSE deleted: %v, want %v", deleted, tc.deleted)
+			}
+			if tc.deleted && (len(store.deletes) != 2 || store.deletes[1] != "2") {
+				t.Fatalf("deletes sent with versions %q, want the retry with the fresh version", store.deletes)
+			}
+			if !tc.deleted && len(store.deletes) != 1 {
+				t.Fatalf("deletes sent with versions %q, want no retry", store.deletes)
+			}
+		})
+	}
+}
+
+func TestNSEClient_DeleteConflictGone(t *testing.T) {
+	store := &nseStore{}
+	c := &nseClient{
+		NetworkServiceEndpointInterface: &conflictOnce{nseStore: store},
+		policy:                          &retrypolicy.Default,
+		mode:                            expiration.Lenient,
+	}
+
+	stale := "1"
+	if err := c.Delete(context.Background(), "nse", metav1.DeleteOptions{
+		Preconditions: &metav1.Preconditions{ResourceVersion: &stale},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if len(store.deletes) != 0 {
+		t.Fatalf("deletes sent with versions %q after the NSE is gone", store.deletes)
+	}
+}
+
+func TestNSEClient_DeleteWithoutVersion(t *testing.T) {
+	store := &nseStore{nse: &v1.NetworkServiceEndpoint{
+		ObjectMeta: metav1.ObjectMeta{Name: "nse", ResourceVersion: "2"},
+		Spec:       v1.NetworkServiceEndpointSpec{ExpirationTime: timestamppb.New(time.Now().Add(time.Minute))},
+	}}
+	c := &nseClient{
+		NetworkServiceEndpointInterface: store,
+		policy:                          &retrypolicy.Default,
+		mode:                            expiration.Lenient,
+	}
+
+	if err := c.Delete(context.Background(), "nse", metav1.DeleteOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if store.nse != nil {
+		t.Fatal("NSE is not deleted by an unconditional delete")
+	}
+}
+
+// conflictOnce fails the first delete with a conflict, as if the NSE had been deleted concurrently after it
+type conflictOnce struct {
+	*nseStore
+	failed bool
+}
+
+func (c *conflictOnce) Delete(ctx context.Context, name string, opts metav1.DeleteOptions) error {
+	if !c.failed {
+		c.failed = true
+		return apierrors.NewConflict(schema.GroupResource{Resource: "networkserviceendpoints"}, name, nil)
+	}
+	return c.nseStore.Delete(ctx, name, opts)
+}
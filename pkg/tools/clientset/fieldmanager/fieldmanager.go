@@ -0,0 +1,127 @@
+// Copyright (c) 2026 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package fieldmanager provides a clientset that sets the field manager on all writes of the registry
+// and controls conflict resolution of server-side apply patches
+package fieldmanager
+
+import (
+	"context"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/networkservicemesh/sdk/pkg/tools/log"
+
+	v1 "github.com/networkservicemesh/sdk-k8s/pkg/tools/k8s/apis/networkservicemesh.io/v1"
+	"github.com/networkservicemesh/sdk-k8s/pkg/tools/k8s/client/clientset/versioned"
+	nsmv1 "github.com/networkservicemesh/sdk-k8s/pkg/tools/k8s/client/clientset/versioned/typed/networkservicemesh.io/v1"
+
+	"github.com/networkservicemesh/cmd-registry-k8s/pkg/tools/clientset"
+)
+
+// NewClientSet returns the client that writes NSEs and NSs with the fieldManager. If force is true, server-side apply
+// patches rejected because of conflicts with other field managers are retried with force, taking over the fields.
+func NewClientSet(client versioned.Interface, fieldManager string, force bool) versioned.Interface {
+	return clientset.New(client,
+		clientset.WithNetworkServiceEndpoints(func(_ string, c nsmv1.NetworkServiceEndpointInterface) nsmv1.NetworkServiceEndpointInterface {
+			return &nseClient{
+				NetworkServiceEndpointInterface: c,
+				fieldManager:                    fieldManager,
+				force:                           force,
+			}
+		}),
+		clientset.WithNetworkServices(func(_ string, c nsmv1.NetworkServiceInterface) nsmv1.NetworkServiceInterface {
+			return &nsClient{
+				NetworkServiceInterface: c,
+				fieldManager:            fieldManager,
+				force:                   force,
+			}
+		}),
+	)
+}
+
+type nseClient struct {
+	nsmv1.NetworkServiceEndpointInterface
+	fieldManager string
+	force        bool
+}
+
+func (c *nseClient) Create(ctx context.Context, nse *v1.NetworkServiceEndpoint, opts metav1.CreateOptions) (*v1.NetworkServiceEndpoint, error) {
+	if opts.FieldManager == "" {
+		opts.FieldManager = c.fieldManager
+	}
+	return c.NetworkServiceEndpointInterface.Create(ctx, nse, opts)
+}
+
+func (c *nseClient) Update(ctx context.Context, nse *v1.NetworkServiceEndpoint, opts metav1.UpdateOptions) (*v1.NetworkServiceEndpoint, error) {
+	if opts.FieldManager == "" {
+		opts.FieldManager = c.fieldManager
+	}
+	return c.NetworkServiceEndpointInterface.Update(ctx, nse, opts)
+}
+
+func (c *nseClient) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions, subresources ...string) (*v1.NetworkServiceEndpoint, error) {
+	if opts.FieldManager == "" {
+		opts.FieldManager = c.fieldManager
+	}
+	nse, err := c.NetworkServiceEndpointInterface.Patch(ctx, name, pt, data, opts, subresources...)
+	if forceRequired(c.force, pt, opts, err) {
+		log.FromContext(ctx).WithField("fieldmanager", "Patch").WithField("nse_name", name).Warnf("forcing apply of NSE %s over other field managers: %v", name, err.Error())
+		opts.Force = &c.force
+		return c.NetworkServiceEndpointInterface.Patch(ctx, name, pt, data, opts, subresources...)
+	}
+	return nse, err
+}
+
+type nsClient struct {
+	nsmv1.NetworkServiceInterface
+	fieldManager string
+	force        bool
+}
+
+func (c *nsClient) Create(ctx context.Context, ns *v1.NetworkService, opts metav1.CreateOptions) (*v1.NetworkService, error) {
+	if opts.FieldManager == "" {
+		opts.FieldManager = c.fieldManager
+	}
+	return c.NetworkServiceInterface.Create(ctx, ns, opts)
+}
+
+func (c *nsClient) Update(ctx context.Context, ns *v1.NetworkService, opts metav1.UpdateOptions) (*v1.NetworkService, error) {
+	if opts.FieldManager == "" {
+		opts.FieldManager = c.fieldManager
+	}
+	return c.NetworkServiceInterface.Update(ctx, ns, opts)
+}
+
+func (c *nsClient) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions, subresources ...string) (*v1.NetworkService, error) {
+	if opts.FieldManager == "" {
+		opts.FieldManager = c.fieldManager
+	}
+	ns, err := c.NetworkServiceInterface.Patch(ctx, name, pt, data, opts, subresources...)
+	if forceRequired(c.force, pt, opts, err) {
+		log.FromContext(ctx).WithField("fieldmanager", "Patch").Warnf("forcing apply of NS %s over other field managers: %v", name, err.Error())
+		opts.Force = &c.force
+		return c.NetworkServiceInterface.Patch(ctx, name, pt, data, opts, subresources...)
+	}
+	return ns, err
+}
+
+// forceRequired returns true if the apply patch failed because of a conflict and should be retried with force
+func forceRequired(force bool, pt types.PatchType, opts metav1.PatchOptions, err error) bool {
+	return force && pt == types.ApplyPatchType && (opts.Force == nil || !*opts.Force) && apierrors.IsConflict(err)
+}
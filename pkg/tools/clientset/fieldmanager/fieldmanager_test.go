@@ -0,0 +1,107 @@
+// Copyright (c) 2026 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fieldmanager
+
+import (
+	"context"
+	"testing"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+
+	v1 "github.com/networkservicemesh/sdk-k8s/pkg/tools/k8s/apis/networkservicemesh.io/v1"
+	nsmv1 "github.com/networkservicemesh/sdk-k8s/pkg/tools/k8s/client/clientset/versioned/typed/networkservicemesh.io/v1"
+)
+
+const fieldManager = "registry-k8s"
+
+// patchRecorder records the options of the NSE patches and fails the ones without force with err
+type patchRecorder struct {
+	nsmv1.NetworkServiceEndpointInterface
+	err   error
+	calls []metav1.PatchOptions
+}
+
+func (r *patchRecorder) Patch(_ context.Context, name string, _ types.PatchType, _ []byte, opts metav1.PatchOptions, _ ...string) (*v1.NetworkServiceEndpoint, error) {
+	r.calls = append(r.calls, opts)
+	if opts.Force != nil && *opts.Force {
+		return &v1.NetworkServiceEndpoint{ObjectMeta: metav1.ObjectMeta{Name: name}}, nil
+	}
+	return nil, r.err
+}
+
+func TestNSEClient_Patch(t *testing.T) {
+	conflict := apierrors.NewConflict(schema.GroupResource{Resource: "networkserviceendpoints"}, "nse", nil)
+	for _, tc := range []struct {
+		name      string
+		force     bool
+		patchType types.PatchType
+		err       error
+		calls     int
+		failed    bool
+	}{
+		{name: "non-force apply conflict", patchType: types.ApplyPatchType, err: conflict, calls: 1, failed: true},
+		{name: "force apply conflict", force: true, patchType: types.ApplyPatchType, err: conflict, calls: 2},
+		{name: "force merge patch conflict", force: true, patchType: types.MergePatchType, err: conflict, calls: 1, failed: true},
+		{name: "force apply other error", force: true, patchType: types.ApplyPatchType, err: apierrors.NewBadRequest("bad"), calls: 1, failed: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			recorder := &patchRecorder{err: tc.err}
+			c := &nseClient{
+				NetworkServiceEndpointInterface: recorder,
+				fieldManager:                    fieldManager,
+				force:                           tc.force,
+			}
+
+			_, err := c.Patch(context.Background(), "nse", tc.patchType, []byte("{}"), metav1.PatchOptions{})
+			if tc.failed != (err != nil) {
+				t.Fatalf("unexpected patch error: %v", err)
+			}
+			if len(recorder.calls) != tc.calls {
+				t.Fatalf("%d patches sent, want %d", len(recorder.calls), tc.calls)
+			}
+			if recorder.calls[0].Force != nil {
+				t.Fatal("first patch is forced")
+			}
+			for _, opts := range recorder.calls {
+				if opts.FieldManager != fieldManager {
+					t.Fatalf("patch is sent with field manager %q, want %q", opts.FieldManager, fieldManager)
+				}
+			}
+			if tc.calls > 1 && (recorder.calls[1].Force == nil || !*recorder.calls[1].Force) {
+				t.Fatal("retried patch is not forced")
+			}
+		})
+	}
+}
+
+func TestNSEClient_PatchKeepsFieldManager(t *testing.T) {
+	recorder := new(patchRecorder)
+	c := &nseClient{
+		NetworkServiceEndpointInterface: recorder,
+		fieldManager:                    fieldManager,
+	}
+
+	if _, err := c.Patch(context.Background(), "nse", types.ApplyPatchType, []byte("{}"), metav1.PatchOptions{FieldManager: "other"}); err != nil {
+		t.Fatal(err)
+	}
+	if got := recorder.calls[0].FieldManager; got != "other" {
+		t.Fatalf("patch is sent with field manager %q, want other", got)
+	}
+}
@@ -0,0 +1,102 @@
+// Copyright (c) 2026 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package idempotentdelete provides a clientset treating deletes of already deleted NSEs and NSs as successful
+package idempotentdelete
+
+import (
+	"context"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/networkservicemesh/sdk/pkg/tools/log"
+
+	"github.com/networkservicemesh/sdk-k8s/pkg/tools/k8s/client/clientset/versioned"
+	nsmv1 "github.com/networkservicemesh/sdk-k8s/pkg/tools/k8s/client/clientset/versioned/typed/networkservicemesh.io/v1"
+
+	"github.com/networkservicemesh/cmd-registry-k8s/pkg/tools/clientset"
+)
+
+// NewClientSet returns the client whose NSE and NS deletes succeed if the object doesn't exist anymore, e.g. because it
+// has been deleted by kubectl or a GC sweep, so Unregister of such objects succeeds instead of being retried by clients.
+// A delete with a UID precondition failed because the object has been deleted and created again is treated the same way.
+func NewClientSet(client versioned.Interface) versioned.Interface {
+	return clientset.New(client,
+		clientset.WithNetworkServiceEndpoints(func(_ string, c nsmv1.NetworkServiceEndpointInterface) nsmv1.NetworkServiceEndpointInterface {
+			return &nseClient{
+				NetworkServiceEndpointInterface: c,
+			}
+		}),
+		clientset.WithNetworkServices(func(_ string, c nsmv1.NetworkServiceInterface) nsmv1.NetworkServiceInterface {
+			return &nsClient{
+				NetworkServiceInterface: c,
+			}
+		}),
+	)
+}
+
+type nseClient struct {
+	nsmv1.NetworkServiceEndpointInterface
+}
+
+func (c *nseClient) Delete(ctx context.Context, name string, opts metav1.DeleteOptions) error {
+	err := c.NetworkServiceEndpointInterface.Delete(ctx, name, opts)
+	if isUIDConflict(err, opts) {
+		nse, getErr := c.Get(ctx, name, metav1.GetOptions{})
+		if getErr != nil {
+			return ignoreNotFound(ctx, "NSE", name, getErr)
+		}
+		if nse.UID != *opts.Preconditions.UID {
+			return alreadyDeleted(ctx, "NSE", name)
+		}
+	}
+	return ignoreNotFound(ctx, "NSE", name, err)
+}
+
+type nsClient struct {
+	nsmv1.NetworkServiceInterface
+}
+
+func (c *nsClient) Delete(ctx context.Context, name string, opts metav1.DeleteOptions) error {
+	err := c.NetworkServiceInterface.Delete(ctx, name, opts)
+	if isUIDConflict(err, opts) {
+		ns, getErr := c.Get(ctx, name, metav1.GetOptions{})
+		if getErr != nil {
+			return ignoreNotFound(ctx, "NS", name, getErr)
+		}
+		if ns.UID != *opts.Preconditions.UID {
+			return alreadyDeleted(ctx, "NS", name)
+		}
+	}
+	return ignoreNotFound(ctx, "NS", name, err)
+}
+
+func isUIDConflict(err error, opts metav1.DeleteOptions) bool {
+	return apierrors.IsConflict(err) && opts.Preconditions != nil && opts.Preconditions.UID != nil
+}
+
+func ignoreNotFound(ctx context.Context, kind, name string, err error) error {
+	if !apierrors.IsNotFound(err) {
+		return err
+	}
+	return alreadyDeleted(ctx, kind, name)
+}
+
+func alreadyDeleted(ctx context.Context, kind, name string) error {
+	log.FromContext(ctx).WithField("idempotentdelete", "Delete").Debugf("%s %s is already deleted", kind, name)
+	return nil
+}
@@ -0,0 +1,189 @@
+// Copyright (c) 2026 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package informercache provides a clientset that serves NSE and NS lists and watches from shared informer caches
+// instead of the API server
+package informercache
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/proto"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+
+	"github.com/networkservicemesh/api/pkg/api/registry"
+
+	v1 "github.com/networkservicemesh/sdk-k8s/pkg/tools/k8s/apis/networkservicemesh.io/v1"
+	"github.com/networkservicemesh/sdk-k8s/pkg/tools/k8s/client/clientset/versioned"
+	nsmv1 "github.com/networkservicemesh/sdk-k8s/pkg/tools/k8s/client/clientset/versioned/typed/networkservicemesh.io/v1"
+	"github.com/networkservicemesh/sdk-k8s/pkg/tools/k8s/client/informers/externalversions"
+	informersv1 "github.com/networkservicemesh/sdk-k8s/pkg/tools/k8s/client/informers/externalversions/networkservicemesh.io/v1"
+
+	"github.com/networkservicemesh/cmd-registry-k8s/pkg/tools/clientset"
+)
+
+// NewClientSet returns the client that serves NSE and NS lists and watches without selectors, resource versions and
+// pagination from the informer caches. The caches watch NSEs and NSs in all namespaces until ctx is done. Requests are
+// served from the API server until the caches are synced, after that they are eventually consistent with the API
+// server. Gets are always served from the API server, because their results are used as a base for updates.
+func NewClientSet(ctx context.Context, client versioned.Interface) versioned.Interface {
+	factory := externalversions.NewSharedInformerFactory(client, 0)
+	nseInformer := factory.Networkservicemesh().V1().NetworkServiceEndpoints()
+	nsInformer := factory.Networkservicemesh().V1().NetworkServices()
+	// Informers have to be requested before the factory start to be started
+	_ = nseInformer.Informer()
+	_ = nsInformer.Informer()
+	factory.Start(ctx.Done())
+
+	return clientset.New(client,
+		clientset.WithNetworkServiceEndpoints(func(namespace string, c nsmv1.NetworkServiceEndpointInterface) nsmv1.NetworkServiceEndpointInterface {
+			return &nseClient{
+				NetworkServiceEndpointInterface: c,
+				namespace:                       namespace,
+				informer:                        nseInformer,
+			}
+		}),
+		clientset.WithNetworkServices(func(namespace string, c nsmv1.NetworkServiceInterface) nsmv1.NetworkServiceInterface {
+			return &nsClient{
+				NetworkServiceInterface: c,
+				namespace:               namespace,
+				informer:                nsInformer,
+			}
+		}),
+	)
+}
+
+type nseClient struct {
+	nsmv1.NetworkServiceEndpointInterface
+	namespace string
+	informer  informersv1.NetworkServiceEndpointInformer
+}
+
+func (c *nseClient) List(ctx context.Context, opts metav1.ListOptions) (*v1.NetworkServiceEndpointList, error) {
+	if !cacheable(opts) || !c.informer.Informer().HasSynced() {
+		return c.NetworkServiceEndpointInterface.List(ctx, opts)
+	}
+
+	var items []*v1.NetworkServiceEndpoint
+	var err error
+	if c.namespace == metav1.NamespaceAll {
+		items, err = c.informer.Lister().List(labels.Everything())
+	} else {
+		items, err = c.informer.Lister().NetworkServiceEndpoints(c.namespace).List(labels.Everything())
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	result := &v1.NetworkServiceEndpointList{
+		ListMeta: metav1.ListMeta{
+			ResourceVersion: c.informer.Informer().LastSyncResourceVersion(),
+		},
+		Items: make([]v1.NetworkServiceEndpoint, len(items)),
+	}
+	for i, item := range items {
+		copyNSE(&result.Items[i], item)
+	}
+	return result, nil
+}
+
+func (c *nseClient) Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {
+	if !cacheable(opts) || !c.informer.Informer().HasSynced() {
+		return c.NetworkServiceEndpointInterface.Watch(ctx, opts)
+	}
+	return watchInformer(ctx, c.informer.Informer(), opts, func(obj interface{}) (runtime.Object, bool) {
+		item, ok := obj.(*v1.NetworkServiceEndpoint)
+		if !ok || (c.namespace != metav1.NamespaceAll && item.GetNamespace() != c.namespace) {
+			return nil, false
+		}
+		nse := new(v1.NetworkServiceEndpoint)
+		copyNSE(nse, item)
+		return nse, true
+	})
+}
+
+type nsClient struct {
+	nsmv1.NetworkServiceInterface
+	namespace string
+	informer  informersv1.NetworkServiceInformer
+}
+
+func (c *nsClient) List(ctx context.Context, opts metav1.ListOptions) (*v1.NetworkServiceList, error) {
+	if !cacheable(opts) || !c.informer.Informer().HasSynced() {
+		return c.NetworkServiceInterface.List(ctx, opts)
+	}
+
+	var items []*v1.NetworkService
+	var err error
+	if c.namespace == metav1.NamespaceAll {
+		items, err = c.informer.Lister().List(labels.Everything())
+	} else {
+		items, err = c.informer.Lister().NetworkServices(c.namespace).List(labels.Everything())
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	result := &v1.NetworkServiceList{
+		ListMeta: metav1.ListMeta{
+			ResourceVersion: c.informer.Informer().LastSyncResourceVersion(),
+		},
+		Items: make([]v1.NetworkService, len(items)),
+	}
+	for i, item := range items {
+		copyNS(&result.Items[i], item)
+	}
+	return result, nil
+}
+
+func (c *nsClient) Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {
+	if !cacheable(opts) || !c.informer.Informer().HasSynced() {
+		return c.NetworkServiceInterface.Watch(ctx, opts)
+	}
+	return watchInformer(ctx, c.informer.Informer(), opts, func(obj interface{}) (runtime.Object, bool) {
+		item, ok := obj.(*v1.NetworkService)
+		if !ok || (c.namespace != metav1.NamespaceAll && item.GetNamespace() != c.namespace) {
+			return nil, false
+		}
+		ns := new(v1.NetworkService)
+		copyNS(ns, item)
+		return ns, true
+	})
+}
+
+// cacheable returns true if the list or watch can be served from the cache
+func cacheable(opts metav1.ListOptions) bool {
+	return opts.LabelSelector == "" && opts.FieldSelector == "" && opts.ResourceVersion == "" &&
+		opts.Limit == 0 && opts.Continue == "" && opts.SendInitialEvents == nil
+}
+
+// Cached objects are shared, so callers get copies they are free to modify. Specs are cloned with proto, because the
+// generated DeepCopy skips some of their fields.
+
+func copyNSE(dst, src *v1.NetworkServiceEndpoint) {
+	dst.TypeMeta = src.TypeMeta
+	src.ObjectMeta.DeepCopyInto(&dst.ObjectMeta)
+	proto.Merge((*registry.NetworkServiceEndpoint)(&dst.Spec), (*registry.NetworkServiceEndpoint)(&src.Spec))
+}
+
+func copyNS(dst, src *v1.NetworkService) {
+	dst.TypeMeta = src.TypeMeta
+	src.ObjectMeta.DeepCopyInto(&dst.ObjectMeta)
+	proto.Merge((*registry.NetworkService)(&dst.Spec), (*registry.NetworkService)(&src.Spec))
+}
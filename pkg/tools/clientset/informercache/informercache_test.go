@@ -0,0 +1,156 @@
+// Copyright (c) 2026 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package informercache
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8stesting "k8s.io/client-go/testing"
+
+	v1 "github.com/networkservicemesh/sdk-k8s/pkg/tools/k8s/apis/networkservicemesh.io/v1"
+	"github.com/networkservicemesh/sdk-k8s/pkg/tools/k8s/client/clientset/versioned/fake"
+	nsmv1 "github.com/networkservicemesh/sdk-k8s/pkg/tools/k8s/client/clientset/versioned/typed/networkservicemesh.io/v1"
+)
+
+const (
+	namespace      = "ns-1"
+	otherNamespace = "ns-2"
+	waitTimeout    = 5 * time.Second
+)
+
+func newNSE(namespace, name, url string) *v1.NetworkServiceEndpoint {
+	nse := &v1.NetworkServiceEndpoint{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+	}
+	nse.Spec.Url = url
+	return nse
+}
+
+// urls returns the URLs of the NSEs listed by nses by name
+func urls(t *testing.T, nses nsmv1.NetworkServiceEndpointInterface) map[string]string {
+	t.Helper()
+	list, err := nses.List(context.Background(), metav1.ListOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	result := make(map[string]string)
+	for i := range list.Items {
+		result[list.Items[i].GetName()] = list.Items[i].Spec.Url
+	}
+	return result
+}
+
+func eventually(t *testing.T, condition func() bool, format string, args ...interface{}) {
+	t.Helper()
+	for start := time.Now(); time.Since(start) < waitTimeout; time.Sleep(10 * time.Millisecond) {
+		if condition() {
+			return
+		}
+	}
+	t.Fatalf(format, args...)
+}
+
+func TestNSEClient_List(t *testing.T) {
+	api := fake.NewSimpleClientset(newNSE(namespace, "nse-1", "tcp://1.1.1.1:5000"))
+	var apiLists atomic.Int32
+	api.PrependReactor("list", "networkserviceendpoints", func(k8stesting.Action) (bool, runtime.Object, error) {
+		apiLists.Add(1)
+		return false, nil, nil
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	nses := NewClientSet(ctx, api).NetworkservicemeshV1().NetworkServiceEndpoints(namespace)
+
+	eventually(t, func() bool {
+		before := apiLists.Load()
+		listed := urls(t, nses)
+		return apiLists.Load() == before && len(listed) == 1
+	}, "lists are not served from the cache")
+
+	t.Run("served from the API server with selectors", func(t *testing.T) {
+		before := apiLists.Load()
+		if _, err := nses.List(ctx, metav1.ListOptions{LabelSelector: "app=nse"}); err != nil {
+			t.Fatal(err)
+		}
+		if apiLists.Load() == before {
+			t.Fatal("list with a label selector is served from the cache")
+		}
+	})
+
+	t.Run("reflects updates", func(t *testing.T) {
+		apiNSEs := api.NetworkservicemeshV1().NetworkServiceEndpoints(namespace)
+		if _, err := apiNSEs.Update(ctx, newNSE(namespace, "nse-1", "tcp://2.2.2.2:5000"), metav1.UpdateOptions{}); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := apiNSEs.Create(ctx, newNSE(namespace, "nse-2", "tcp://3.3.3.3:5000"), metav1.CreateOptions{}); err != nil {
+			t.Fatal(err)
+		}
+		want := map[string]string{"nse-1": "tcp://2.2.2.2:5000", "nse-2": "tcp://3.3.3.3:5000"}
+		eventually(t, func() bool {
+			listed := urls(t, nses)
+			return len(listed) == len(want) && listed["nse-1"] == want["nse-1"] && listed["nse-2"] == want["nse-2"]
+		}, "cached NSEs are not updated, want %v", want)
+
+		if err := apiNSEs.Delete(ctx, "nse-2", metav1.DeleteOptions{}); err != nil {
+			t.Fatal(err)
+		}
+		eventually(t, func() bool {
+			_, ok := urls(t, nses)["nse-2"]
+			return !ok
+		}, "deleted NSE is still cached")
+	})
+
+	t.Run("filters namespaces", func(t *testing.T) {
+		if _, err := api.NetworkservicemeshV1().NetworkServiceEndpoints(otherNamespace).Create(ctx, newNSE(otherNamespace, "nse-3", "tcp://4.4.4.4:5000"), metav1.CreateOptions{}); err != nil {
+			t.Fatal(err)
+		}
+		all := NewClientSet(ctx, api).NetworkservicemeshV1().NetworkServiceEndpoints(metav1.NamespaceAll)
+		eventually(t, func() bool {
+			_, ok := urls(t, all)["nse-3"]
+			return ok
+		}, "NSE of another namespace is not listed in all namespaces")
+		if _, ok := urls(t, nses)["nse-3"]; ok {
+			t.Fatal("NSE of another namespace is listed")
+		}
+	})
+
+	t.Run("returns copies", func(t *testing.T) {
+		list, err := nses.List(ctx, metav1.ListOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		list.Items[0].Spec.Url = "modified"
+		list.Items[0].Labels = map[string]string{"modified": "true"}
+
+		list, err = nses.List(ctx, metav1.ListOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if list.Items[0].Spec.Url == "modified" || list.Items[0].Labels["modified"] != "" {
+			t.Fatal("cached NSE is modified by the caller")
+		}
+	})
+}
@@ -0,0 +1,93 @@
+// Copyright (c) 2026 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package informercache
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/tools/cache"
+)
+
+// watchInformer returns the watch sending events of the informer. As for the API server watch without a resource
+// version, it starts with Added events for all cached objects. convert filters and copies the objects. The watch is
+// stopped on Stop, when ctx is done or after opts.TimeoutSeconds.
+func watchInformer(ctx context.Context, informer cache.SharedIndexInformer, opts metav1.ListOptions, convert func(obj interface{}) (runtime.Object, bool)) (watch.Interface, error) {
+	ch := make(chan watch.Event)
+	w := watch.NewProxyWatcher(ch)
+
+	var mu sync.Mutex
+	closed := false
+	send := func(eventType watch.EventType, obj interface{}) {
+		if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+			obj = tombstone.Obj
+		}
+		object, ok := convert(obj)
+		if !ok {
+			return
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		if closed {
+			return
+		}
+		select {
+		case ch <- watch.Event{Type: eventType, Object: object}:
+		case <-w.StopChan():
+		}
+	}
+
+	registration, err := informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			send(watch.Added, obj)
+		},
+		UpdateFunc: func(_, obj interface{}) {
+			send(watch.Modified, obj)
+		},
+		DeleteFunc: func(obj interface{}) {
+			send(watch.Deleted, obj)
+		},
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to add an informer event handler")
+	}
+
+	var timeoutCh <-chan time.Time
+	if opts.TimeoutSeconds != nil {
+		timeoutCh = time.After(time.Duration(*opts.TimeoutSeconds) * time.Second)
+	}
+	go func() {
+		select {
+		case <-w.StopChan():
+		case <-ctx.Done():
+		case <-timeoutCh:
+		}
+		_ = informer.RemoveEventHandler(registration)
+		w.Stop()
+
+		mu.Lock()
+		defer mu.Unlock()
+		closed = true
+		close(ch)
+	}()
+	return w, nil
+}
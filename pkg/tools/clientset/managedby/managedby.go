@@ -0,0 +1,119 @@
+// Copyright (c) 2026 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package managedby provides a clientset annotating NSEs with the registry replica which has written them last, so
+// other replicas know which replica tracks the expiration of an NSE
+package managedby
+
+import (
+	"context"
+	"encoding/json"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/networkservicemesh/sdk/pkg/tools/log"
+
+	v1 "github.com/networkservicemesh/sdk-k8s/pkg/tools/k8s/apis/networkservicemesh.io/v1"
+	"github.com/networkservicemesh/sdk-k8s/pkg/tools/k8s/client/clientset/versioned"
+	nsmv1 "github.com/networkservicemesh/sdk-k8s/pkg/tools/k8s/client/clientset/versioned/typed/networkservicemesh.io/v1"
+
+	"github.com/networkservicemesh/cmd-registry-k8s/pkg/tools/clientset"
+)
+
+// Annotation is set to the identity of the replica which has created or updated the NSE last
+const Annotation = "networkservicemesh.io/managed-by"
+
+// NewClientSet returns the client setting the Annotation of created and updated NSEs to identity and removing the
+// clearAnnotations from them. Annotations dropped by the update, e.g. by server-side apply patches, are set with
+// a separate patch.
+func NewClientSet(client versioned.Interface, identity string, clearAnnotations ...string) versioned.Interface {
+	return clientset.New(client,
+		clientset.WithNetworkServiceEndpoints(func(_ string, c nsmv1.NetworkServiceEndpointInterface) nsmv1.NetworkServiceEndpointInterface {
+			return &nseClient{
+				NetworkServiceEndpointInterface: c,
+				identity:                        identity,
+				clearAnnotations:                clearAnnotations,
+			}
+		}),
+	)
+}
+
+type nseClient struct {
+	nsmv1.NetworkServiceEndpointInterface
+	identity         string
+	clearAnnotations []string
+}
+
+func (c *nseClient) Create(ctx context.Context, nse *v1.NetworkServiceEndpoint, opts metav1.CreateOptions) (*v1.NetworkServiceEndpoint, error) {
+	created, err := c.NetworkServiceEndpointInterface.Create(ctx, c.annotate(nse), opts)
+	if err != nil {
+		return nil, err
+	}
+	return c.ensure(ctx, created)
+}
+
+func (c *nseClient) Update(ctx context.Context, nse *v1.NetworkServiceEndpoint, opts metav1.UpdateOptions) (*v1.NetworkServiceEndpoint, error) {
+	updated, err := c.NetworkServiceEndpointInterface.Update(ctx, c.annotate(nse), opts)
+	if err != nil {
+		return nil, err
+	}
+	return c.ensure(ctx, updated)
+}
+
+// annotate returns a copy of the NSE with the annotations of the replica
+func (c *nseClient) annotate(nse *v1.NetworkServiceEndpoint) *v1.NetworkServiceEndpoint {
+	nse = nse.DeepCopy()
+	if nse.Annotations == nil {
+		nse.Annotations = make(map[string]string)
+	}
+	nse.Annotations[Annotation] = c.identity
+	for _, annotation := range c.clearAnnotations {
+		delete(nse.Annotations, annotation)
+	}
+	return nse
+}
+
+// ensure patches the annotations of the written NSE if the write has not set them. Failures are logged only, because
+// the NSE itself has been written.
+func (c *nseClient) ensure(ctx context.Context, nse *v1.NetworkServiceEndpoint) (*v1.NetworkServiceEndpoint, error) {
+	annotations := map[string]interface{}{}
+	if nse.GetAnnotations()[Annotation] != c.identity {
+		annotations[Annotation] = c.identity
+	}
+	for _, annotation := range c.clearAnnotations {
+		if _, ok := nse.GetAnnotations()[annotation]; ok {
+			annotations[annotation] = nil
+		}
+	}
+	if len(annotations) == 0 {
+		return nse, nil
+	}
+	patch, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"annotations": annotations,
+		},
+	})
+	if err == nil {
+		var patched *v1.NetworkServiceEndpoint
+		if patched, err = c.NetworkServiceEndpointInterface.Patch(ctx, nse.GetName(), types.MergePatchType, patch, metav1.PatchOptions{}); err == nil {
+			return patched, nil
+		}
+	}
+	log.FromContext(ctx).WithField("managedby", "ensure").WithField("nse_name", nse.GetName()).
+		Warnf("failed to annotate NSE %s as managed by %s: %v", nse.GetName(), c.identity, err.Error())
+	return nse, nil
+}
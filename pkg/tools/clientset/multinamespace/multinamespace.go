@@ -0,0 +1,204 @@
+// Copyright (c) 2026 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package multinamespace provides a clientset storing NSEs in the namespaces their requests are routed to and limiting
+// lists and watches of all namespaces to the served namespaces
+package multinamespace
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
+
+	v1 "github.com/networkservicemesh/sdk-k8s/pkg/tools/k8s/apis/networkservicemesh.io/v1"
+	"github.com/networkservicemesh/sdk-k8s/pkg/tools/k8s/client/clientset/versioned"
+	nsmv1 "github.com/networkservicemesh/sdk-k8s/pkg/tools/k8s/client/clientset/versioned/typed/networkservicemesh.io/v1"
+
+	"github.com/networkservicemesh/cmd-registry-k8s/pkg/tools/clientset"
+)
+
+type namespaceKey struct{}
+
+// WithNamespace returns the context routing the NSE calls made with it to the namespace
+func WithNamespace(ctx context.Context, namespace string) context.Context {
+	return context.WithValue(ctx, namespaceKey{}, namespace)
+}
+
+// NamespaceFromContext returns the namespace set by WithNamespace, or an empty string if it is not set
+func NamespaceFromContext(ctx context.Context) string {
+	namespace, _ := ctx.Value(namespaceKey{}).(string)
+	return namespace
+}
+
+// NewClientSet returns the client which makes the NSE calls for the default namespace, except for watches, in the
+// namespace set by WithNamespace instead. Lists and watches of NSEs and NSs in all namespaces return only objects of the default
+// namespace and the namespaces; if namespaces is nil, objects of all namespaces are returned.
+func NewClientSet(client versioned.Interface, defaultNamespace string, namespaces []string) versioned.Interface {
+	var served map[string]bool
+	if namespaces != nil {
+		served = map[string]bool{defaultNamespace: true}
+		for _, namespace := range namespaces {
+			served[namespace] = true
+		}
+	}
+	filter := func(namespace string) bool {
+		return served == nil || served[namespace]
+	}
+
+	return clientset.New(client,
+		clientset.WithNetworkServiceEndpoints(func(namespace string, c nsmv1.NetworkServiceEndpointInterface) nsmv1.NetworkServiceEndpointInterface {
+			switch namespace {
+			case defaultNamespace:
+				return &routedNSEClient{
+					NetworkServiceEndpointInterface: c,
+					client:                          client,
+					namespace:                       namespace,
+				}
+			case metav1.NamespaceAll:
+				return &filteredNSEClient{
+					NetworkServiceEndpointInterface: c,
+					served:                          filter,
+				}
+			}
+			return c
+		}),
+		clientset.WithNetworkServices(func(namespace string, c nsmv1.NetworkServiceInterface) nsmv1.NetworkServiceInterface {
+			if namespace != metav1.NamespaceAll {
+				return c
+			}
+			return &filteredNSClient{
+				NetworkServiceInterface: c,
+				served:                  filter,
+			}
+		}),
+	)
+}
+
+type routedNSEClient struct {
+	nsmv1.NetworkServiceEndpointInterface
+	client    versioned.Interface
+	namespace string
+}
+
+// route returns the client of the namespace of the context and the namespace
+func (c *routedNSEClient) route(ctx context.Context) (nsmv1.NetworkServiceEndpointInterface, string) {
+	if namespace := NamespaceFromContext(ctx); namespace != "" && namespace != c.namespace {
+		return c.client.NetworkservicemeshV1().NetworkServiceEndpoints(namespace), namespace
+	}
+	return c.NetworkServiceEndpointInterface, c.namespace
+}
+
+func (c *routedNSEClient) Get(ctx context.Context, name string, opts metav1.GetOptions) (*v1.NetworkServiceEndpoint, error) {
+	nses, _ := c.route(ctx)
+	return nses.Get(ctx, name, opts)
+}
+
+func (c *routedNSEClient) List(ctx context.Context, opts metav1.ListOptions) (*v1.NetworkServiceEndpointList, error) {
+	nses, _ := c.route(ctx)
+	return nses.List(ctx, opts)
+}
+
+func (c *routedNSEClient) Create(ctx context.Context, nse *v1.NetworkServiceEndpoint, opts metav1.CreateOptions) (*v1.NetworkServiceEndpoint, error) {
+	nses, namespace := c.route(ctx)
+	if nse.GetNamespace() != namespace {
+		nse = nse.DeepCopy()
+		nse.SetNamespace(namespace)
+	}
+	return nses.Create(ctx, nse, opts)
+}
+
+func (c *routedNSEClient) Update(ctx context.Context, nse *v1.NetworkServiceEndpoint, opts metav1.UpdateOptions) (*v1.NetworkServiceEndpoint, error) {
+	nses, namespace := c.route(ctx)
+	if nse.GetNamespace() != namespace {
+		nse = nse.DeepCopy()
+		nse.SetNamespace(namespace)
+	}
+	return nses.Update(ctx, nse, opts)
+}
+
+func (c *routedNSEClient) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions, subresources ...string) (*v1.NetworkServiceEndpoint, error) {
+	nses, _ := c.route(ctx)
+	return nses.Patch(ctx, name, pt, data, opts, subresources...)
+}
+
+func (c *routedNSEClient) Delete(ctx context.Context, name string, opts metav1.DeleteOptions) error {
+	nses, _ := c.route(ctx)
+	return nses.Delete(ctx, name, opts)
+}
+
+type filteredNSEClient struct {
+	nsmv1.NetworkServiceEndpointInterface
+	served func(namespace string) bool
+}
+
+func (c *filteredNSEClient) List(ctx context.Context, opts metav1.ListOptions) (*v1.NetworkServiceEndpointList, error) {
+	list, err := c.NetworkServiceEndpointInterface.List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	items := list.Items[:0]
+	for i := range list.Items {
+		if c.served(list.Items[i].GetNamespace()) {
+			items = append(items, list.Items[i:i+1]...)
+		}
+	}
+	list.Items = items
+	return list, nil
+}
+
+func (c *filteredNSEClient) Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {
+	w, err := c.NetworkServiceEndpointInterface.Watch(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return watch.Filter(w, func(event watch.Event) (watch.Event, bool) {
+		nse, ok := event.Object.(*v1.NetworkServiceEndpoint)
+		return event, !ok || c.served(nse.GetNamespace())
+	}), nil
+}
+
+type filteredNSClient struct {
+	nsmv1.NetworkServiceInterface
+	served func(namespace string) bool
+}
+
+func (c *filteredNSClient) List(ctx context.Context, opts metav1.ListOptions) (*v1.NetworkServiceList, error) {
+	list, err := c.NetworkServiceInterface.List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	items := list.Items[:0]
+	for i := range list.Items {
+		if c.served(list.Items[i].GetNamespace()) {
+			items = append(items, list.Items[i:i+1]...)
+		}
+	}
+	list.Items = items
+	return list, nil
+}
+
+func (c *filteredNSClient) Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {
+	w, err := c.NetworkServiceInterface.Watch(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return watch.Filter(w, func(event watch.Event) (watch.Event, bool) {
+		ns, ok := event.Object.(*v1.NetworkService)
+		return event, !ok || c.served(ns.GetNamespace())
+	}), nil
+}
@@ -0,0 +1,140 @@
+// Copyright (c) 2026 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package pagination provides a clientset that splits NSE and NS lists into pages
+package pagination
+
+import (
+	"context"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "github.com/networkservicemesh/sdk-k8s/pkg/tools/k8s/apis/networkservicemesh.io/v1"
+	"github.com/networkservicemesh/sdk-k8s/pkg/tools/k8s/client/clientset/versioned"
+	nsmv1 "github.com/networkservicemesh/sdk-k8s/pkg/tools/k8s/client/clientset/versioned/typed/networkservicemesh.io/v1"
+
+	"github.com/networkservicemesh/cmd-registry-k8s/pkg/tools/clientset"
+)
+
+// NewClientSet returns the client that lists NSEs and NSs in pages of pageSize objects and joins the pages into a
+// single list. Lists already limited by the caller are passed as is. If the continue token of a page expires, the list
+// is restarted from the first page.
+func NewClientSet(client versioned.Interface, pageSize int64) versioned.Interface {
+	return clientset.New(client,
+		clientset.WithNetworkServiceEndpoints(func(_ string, c nsmv1.NetworkServiceEndpointInterface) nsmv1.NetworkServiceEndpointInterface {
+			return &nseClient{
+				NetworkServiceEndpointInterface: c,
+				pageSize:                        pageSize,
+			}
+		}),
+		clientset.WithNetworkServices(func(_ string, c nsmv1.NetworkServiceInterface) nsmv1.NetworkServiceInterface {
+			return &nsClient{
+				NetworkServiceInterface: c,
+				pageSize:                pageSize,
+			}
+		}),
+	)
+}
+
+// ListNetworkServiceEndpoints lists the NSEs in pages of pageSize NSEs and calls fn with each page, so callers don't
+// have to hold the whole list in memory. first is true for the first page of the list. If the continue token of a page
+// expires, the list is restarted from the first page, so fn may see NSEs more than once. With pageSize 0, the NSEs are
+// listed in a single page.
+func ListNetworkServiceEndpoints(ctx context.Context, nses nsmv1.NetworkServiceEndpointInterface, opts metav1.ListOptions, pageSize int64,
+	fn func(page *v1.NetworkServiceEndpointList, first bool) error) error {
+	return listPages(ctx, nses.List, opts, pageSize, fn)
+}
+
+// listPages is ListNetworkServiceEndpoints for any kind of objects listed by list
+func listPages[L metav1.ListInterface](ctx context.Context, list func(ctx context.Context, opts metav1.ListOptions) (L, error), opts metav1.ListOptions, pageSize int64,
+	fn func(page L, first bool) error) error {
+	opts.Limit, opts.Continue = pageSize, ""
+	for {
+		page, err := list(ctx, opts)
+		if opts.Continue != "" && apierrors.IsResourceExpired(err) {
+			opts.Continue = ""
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if err := fn(page, opts.Continue == ""); err != nil {
+			return err
+		}
+		if page.GetContinue() == "" {
+			return nil
+		}
+		opts.Continue = page.GetContinue()
+	}
+}
+
+type nseClient struct {
+	nsmv1.NetworkServiceEndpointInterface
+	pageSize int64
+}
+
+func (c *nseClient) List(ctx context.Context, opts metav1.ListOptions) (*v1.NetworkServiceEndpointList, error) {
+	if !paginated(opts) {
+		return c.NetworkServiceEndpointInterface.List(ctx, opts)
+	}
+
+	var result *v1.NetworkServiceEndpointList
+	if err := ListNetworkServiceEndpoints(ctx, c.NetworkServiceEndpointInterface, opts, c.pageSize, func(page *v1.NetworkServiceEndpointList, first bool) error {
+		if first {
+			result = page
+		} else {
+			result.Items = append(result.Items, page.Items...)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	result.Continue = ""
+	result.RemainingItemCount = nil
+	return result, nil
+}
+
+type nsClient struct {
+	nsmv1.NetworkServiceInterface
+	pageSize int64
+}
+
+func (c *nsClient) List(ctx context.Context, opts metav1.ListOptions) (*v1.NetworkServiceList, error) {
+	if !paginated(opts) {
+		return c.NetworkServiceInterface.List(ctx, opts)
+	}
+
+	var result *v1.NetworkServiceList
+	if err := listPages(ctx, c.NetworkServiceInterface.List, opts, c.pageSize, func(page *v1.NetworkServiceList, first bool) error {
+		if first {
+			result = page
+		} else {
+			result.Items = append(result.Items, page.Items...)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	result.Continue = ""
+	result.RemainingItemCount = nil
+	return result, nil
+}
+
+// paginated returns true if the list should be split into pages
+func paginated(opts metav1.ListOptions) bool {
+	return opts.Limit == 0 && opts.Continue == "" && !opts.Watch
+}
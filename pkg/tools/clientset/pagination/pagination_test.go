@@ -0,0 +1,134 @@
+// Copyright (c) 2026 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pagination_test
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "github.com/networkservicemesh/sdk-k8s/pkg/tools/k8s/apis/networkservicemesh.io/v1"
+	"github.com/networkservicemesh/sdk-k8s/pkg/tools/k8s/client/clientset/versioned/fake"
+	nsmv1 "github.com/networkservicemesh/sdk-k8s/pkg/tools/k8s/client/clientset/versioned/typed/networkservicemesh.io/v1"
+
+	"github.com/networkservicemesh/cmd-registry-k8s/pkg/tools/clientset"
+	"github.com/networkservicemesh/cmd-registry-k8s/pkg/tools/clientset/pagination"
+)
+
+const namespace = "ns-1"
+
+// pagedNSEs serves pages of the NSEs named nse-0 to nse-<n-1>. The continue token is the index of the next NSE, the
+// continue tokens in expired are rejected with ResourceExpired once.
+type pagedNSEs struct {
+	nsmv1.NetworkServiceEndpointInterface
+	n       int
+	expired map[string]bool
+	lists   []metav1.ListOptions
+}
+
+func (c *pagedNSEs) List(_ context.Context, opts metav1.ListOptions) (*v1.NetworkServiceEndpointList, error) {
+	c.lists = append(c.lists, opts)
+	if c.expired[opts.Continue] {
+		delete(c.expired, opts.Continue)
+		return nil, apierrors.NewResourceExpired("continue token expired")
+	}
+	start := 0
+	if opts.Continue != "" {
+		start, _ = strconv.Atoi(opts.Continue)
+	}
+	end := c.n
+	if opts.Limit > 0 && start+int(opts.Limit) < c.n {
+		end = start + int(opts.Limit)
+	}
+	page := &v1.NetworkServiceEndpointList{ListMeta: metav1.ListMeta{ResourceVersion: "1"}}
+	for i := start; i < end; i++ {
+		page.Items = append(page.Items, v1.NetworkServiceEndpoint{ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("nse-%d", i), Namespace: namespace}})
+	}
+	if end < c.n {
+		page.Continue = strconv.Itoa(end)
+	}
+	return page, nil
+}
+
+func TestListNetworkServiceEndpoints(t *testing.T) {
+	nses := &pagedNSEs{n: 5, expired: map[string]bool{"4": true}}
+
+	var pages [][]string
+	var firsts []bool
+	if err := pagination.ListNetworkServiceEndpoints(context.Background(), nses, metav1.ListOptions{}, 2,
+		func(page *v1.NetworkServiceEndpointList, first bool) error {
+			var names []string
+			for i := range page.Items {
+				names = append(names, page.Items[i].GetName())
+			}
+			pages = append(pages, names)
+			firsts = append(firsts, first)
+			return nil
+		}); err != nil {
+		t.Fatal(err)
+	}
+
+	// The token of the third page expires, so the list is restarted from the first page
+	want := "[[nse-0 nse-1] [nse-2 nse-3] [nse-0 nse-1] [nse-2 nse-3] [nse-4]]"
+	if got := fmt.Sprint(pages); got != want {
+		t.Fatalf("pages %s, want %s", got, want)
+	}
+	if got := fmt.Sprint(firsts); got != "[true false true false false]" {
+		t.Fatalf("first pages %s, want [true false true false false]", got)
+	}
+	for _, opts := range nses.lists {
+		if opts.Limit != 2 {
+			t.Fatalf("page of %d NSEs is listed, want 2", opts.Limit)
+		}
+	}
+}
+
+func TestNSEClient_List(t *testing.T) {
+	nses := &pagedNSEs{n: 5, expired: map[string]bool{"2": true}}
+	client := clientset.New(fake.NewSimpleClientset(), clientset.WithNetworkServiceEndpoints(
+		func(string, nsmv1.NetworkServiceEndpointInterface) nsmv1.NetworkServiceEndpointInterface {
+			return nses
+		}))
+
+	list, err := pagination.NewClientSet(client, 2).NetworkservicemeshV1().NetworkServiceEndpoints(namespace).List(context.Background(), metav1.ListOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for i := range list.Items {
+		names = append(names, list.Items[i].GetName())
+	}
+	if got := fmt.Sprint(names); got != "[nse-0 nse-1 nse-2 nse-3 nse-4]" {
+		t.Fatalf("listed %s, want [nse-0 nse-1 nse-2 nse-3 nse-4]", got)
+	}
+	if list.Continue != "" {
+		t.Fatalf("joined list has continue token %q", list.Continue)
+	}
+	// 1 expired and 4 served pages, none of them a full list
+	if len(nses.lists) != 5 {
+		t.Fatalf("%d lists are made, want 5", len(nses.lists))
+	}
+	for _, opts := range nses.lists {
+		if opts.Limit != 2 {
+			t.Fatalf("page of %d NSEs is listed, want 2", opts.Limit)
+		}
+	}
+}
@@ -0,0 +1,145 @@
+// Copyright (c) 2026 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package priority
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"k8s.io/client-go/util/flowcontrol"
+)
+
+// Priority is the priority of an API call
+type Priority int
+
+const (
+	// High is the priority of writes and of the Gets they are based on
+	High Priority = iota
+	// Low is the priority of Lists and Watches serving Find
+	Low
+)
+
+func (p Priority) String() string {
+	if p == High {
+		return "high"
+	}
+	return "low"
+}
+
+type waiter struct {
+	ready chan struct{}
+}
+
+// dispatcher hands the tokens of the rate limiter to waiting calls, high priority calls first
+type dispatcher struct {
+	limiter flowcontrol.RateLimiter
+	wake    chan struct{}
+
+	mu     sync.Mutex
+	queues [Low + 1][]*waiter
+}
+
+func newDispatcher(qps float32, burst int) *dispatcher {
+	return &dispatcher{
+		limiter: flowcontrol.NewTokenBucketRateLimiter(qps, burst),
+		wake:    make(chan struct{}, 1),
+	}
+}
+
+// run dispatches the tokens until ctx is done
+func (d *dispatcher) run(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-d.wake:
+		}
+		for d.pending() {
+			if err := d.limiter.Wait(ctx); err != nil {
+				return
+			}
+			if w := d.pop(); w != nil {
+				close(w.ready)
+			}
+		}
+	}
+}
+
+// wait blocks until the call of the priority gets a token or ctx is done
+func (d *dispatcher) wait(ctx context.Context, p Priority) error {
+	start := time.Now()
+	w := &waiter{
+		ready: make(chan struct{}),
+	}
+	d.mu.Lock()
+	d.queues[p] = append(d.queues[p], w)
+	d.mu.Unlock()
+	select {
+	case d.wake <- struct{}{}:
+	default:
+	}
+
+	select {
+	case <-w.ready:
+		if waitDuration != nil {
+			waitDuration.Record(ctx, time.Since(start).Seconds(), metric.WithAttributes(attribute.String("priority", p.String())))
+		}
+		return nil
+	case <-ctx.Done():
+		d.remove(p, w)
+		return ctx.Err()
+	}
+}
+
+func (d *dispatcher) pending() bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	for _, q := range d.queues {
+		if len(q) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// pop removes the first call of the highest priority from the queues
+func (d *dispatcher) pop() *waiter {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	for p, q := range d.queues {
+		if len(q) > 0 {
+			d.queues[p] = q[1:]
+			return q[0]
+		}
+	}
+	return nil
+}
+
+func (d *dispatcher) remove(p Priority, w *waiter) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	q := d.queues[p]
+	for i := range q {
+		if q[i] == w {
+			d.queues[p] = append(q[:i:i], q[i+1:]...)
+			return
+		}
+	}
+}
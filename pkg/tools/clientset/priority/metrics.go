@@ -0,0 +1,42 @@
+// Copyright (c) 2026 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package priority
+
+import (
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/metric"
+)
+
+const (
+	meterName                 = "github.com/networkservicemesh/cmd-registry-k8s/pkg/tools/clientset/priority"
+	waitDurationHistogramName = "registry_k8s_priority_wait_duration_seconds"
+)
+
+// waitDuration records the time NSE and NS API calls wait for the rate limiter by priority
+var waitDuration metric.Float64Histogram
+
+func init() {
+	var err error
+	waitDuration, err = otel.Meter(meterName).Float64Histogram(
+		waitDurationHistogramName,
+		metric.WithDescription("Time NSE and NS API calls wait for the rate limiter by priority"),
+		metric.WithUnit("s"),
+	)
+	if err != nil {
+		otel.Handle(err)
+	}
+}
@@ -0,0 +1,164 @@
+// Copyright (c) 2026 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package priority provides a clientset rate limiting NSE and NS API calls with precedence of writes over reads
+package priority
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
+
+	v1 "github.com/networkservicemesh/sdk-k8s/pkg/tools/k8s/apis/networkservicemesh.io/v1"
+	"github.com/networkservicemesh/sdk-k8s/pkg/tools/k8s/client/clientset/versioned"
+	nsmv1 "github.com/networkservicemesh/sdk-k8s/pkg/tools/k8s/client/clientset/versioned/typed/networkservicemesh.io/v1"
+
+	"github.com/networkservicemesh/cmd-registry-k8s/pkg/tools/clientset"
+)
+
+// NewClientSet returns the client that limits NSE and NS calls to qps with burst and, when calls have to wait,
+// dispatches them by priority: Creates, Updates, Patches, Deletes and Gets go first, Lists and Watches only get the
+// rate left over by them. The client rate limiter of the wrapped client should be disabled. Calls are dispatched until
+// ctx is done.
+func NewClientSet(ctx context.Context, client versioned.Interface, qps float32, burst int) versioned.Interface {
+	d := newDispatcher(qps, burst)
+	go d.run(ctx)
+
+	return clientset.New(client,
+		clientset.WithNetworkServiceEndpoints(func(_ string, c nsmv1.NetworkServiceEndpointInterface) nsmv1.NetworkServiceEndpointInterface {
+			return &nseClient{
+				NetworkServiceEndpointInterface: c,
+				dispatcher:                      d,
+			}
+		}),
+		clientset.WithNetworkServices(func(_ string, c nsmv1.NetworkServiceInterface) nsmv1.NetworkServiceInterface {
+			return &nsClient{
+				NetworkServiceInterface: c,
+				dispatcher:              d,
+			}
+		}),
+	)
+}
+
+type nseClient struct {
+	nsmv1.NetworkServiceEndpointInterface
+	dispatcher *dispatcher
+}
+
+func (c *nseClient) Get(ctx context.Context, name string, opts metav1.GetOptions) (*v1.NetworkServiceEndpoint, error) {
+	if err := c.dispatcher.wait(ctx, High); err != nil {
+		return nil, err
+	}
+	return c.NetworkServiceEndpointInterface.Get(ctx, name, opts)
+}
+
+func (c *nseClient) List(ctx context.Context, opts metav1.ListOptions) (*v1.NetworkServiceEndpointList, error) {
+	if err := c.dispatcher.wait(ctx, Low); err != nil {
+		return nil, err
+	}
+	return c.NetworkServiceEndpointInterface.List(ctx, opts)
+}
+
+func (c *nseClient) Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {
+	if err := c.dispatcher.wait(ctx, Low); err != nil {
+		return nil, err
+	}
+	return c.NetworkServiceEndpointInterface.Watch(ctx, opts)
+}
+
+func (c *nseClient) Create(ctx context.Context, nse *v1.NetworkServiceEndpoint, opts metav1.CreateOptions) (*v1.NetworkServiceEndpoint, error) {
+	if err := c.dispatcher.wait(ctx, High); err != nil {
+		return nil, err
+	}
+	return c.NetworkServiceEndpointInterface.Create(ctx, nse, opts)
+}
+
+func (c *nseClient) Update(ctx context.Context, nse *v1.NetworkServiceEndpoint, opts metav1.UpdateOptions) (*v1.NetworkServiceEndpoint, error) {
+	if err := c.dispatcher.wait(ctx, High); err != nil {
+		return nil, err
+	}
+	return c.NetworkServiceEndpointInterface.Update(ctx, nse, opts)
+}
+
+func (c *nseClient) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions, subresources ...string) (*v1.NetworkServiceEndpoint, error) {
+	if err := c.dispatcher.wait(ctx, High); err != nil {
+		return nil, err
+	}
+	return c.NetworkServiceEndpointInterface.Patch(ctx, name, pt, data, opts, subresources...)
+}
+
+func (c *nseClient) Delete(ctx context.Context, name string, opts metav1.DeleteOptions) error {
+	if err := c.dispatcher.wait(ctx, High); err != nil {
+		return err
+	}
+	return c.NetworkServiceEndpointInterface.Delete(ctx, name, opts)
+}
+
+type nsClient struct {
+	nsmv1.NetworkServiceInterface
+	dispatcher *dispatcher
+}
+
+func (c *nsClient) Get(ctx context.Context, name string, opts metav1.GetOptions) (*v1.NetworkService, error) {
+	if err := c.dispatcher.wait(ctx, High); err != nil {
+		return nil, err
+	}
+	return c.NetworkServiceInterface.Get(ctx, name, opts)
+}
+
+func (c *nsClient) List(ctx context.Context, opts metav1.ListOptions) (*v1.NetworkServiceList, error) {
+	if err := c.dispatcher.wait(ctx, Low); err != nil {
+		return nil, err
+	}
+	return c.NetworkServiceInterface.List(ctx, opts)
+}
+
+func (c *nsClient) Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {
+	if err := c.dispatcher.wait(ctx, Low); err != nil {
+		return nil, err
+	}
+	return c.NetworkServiceInterface.Watch(ctx, opts)
+}
+
+func (c *nsClient) Create(ctx context.Context, ns *v1.NetworkService, opts metav1.CreateOptions) (*v1.NetworkService, error) {
+	if err := c.dispatcher.wait(ctx, High); err != nil {
+		return nil, err
+	}
+	return c.NetworkServiceInterface.Create(ctx, ns, opts)
+}
+
+func (c *nsClient) Update(ctx context.Context, ns *v1.NetworkService, opts metav1.UpdateOptions) (*v1.NetworkService, error) {
+	if err := c.dispatcher.wait(ctx, High); err != nil {
+		return nil, err
+	}
+	return c.NetworkServiceInterface.Update(ctx, ns, opts)
+}
+
+func (c *nsClient) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions, subresources ...string) (*v1.NetworkService, error) {
+	if err := c.dispatcher.wait(ctx, High); err != nil {
+		return nil, err
+	}
+	return c.NetworkServiceInterface.Patch(ctx, name, pt, data, opts, subresources...)
+}
+
+func (c *nsClient) Delete(ctx context.Context, name string, opts metav1.DeleteOptions) error {
+	if err := c.dispatcher.wait(ctx, High); err != nil {
+		return err
+	}
+	return c.NetworkServiceInterface.Delete(ctx, name, opts)
+}
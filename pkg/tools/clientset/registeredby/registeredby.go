@@ -0,0 +1,117 @@
+// Copyright (c) 2026 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package registeredby provides a clientset annotating the written NSEs with the SPIFFE ID registering them
+package registeredby
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	v1 "github.com/networkservicemesh/sdk-k8s/pkg/tools/k8s/apis/networkservicemesh.io/v1"
+	"github.com/networkservicemesh/sdk-k8s/pkg/tools/k8s/client/clientset/versioned"
+	nsmv1 "github.com/networkservicemesh/sdk-k8s/pkg/tools/k8s/client/clientset/versioned/typed/networkservicemesh.io/v1"
+
+	"github.com/networkservicemesh/cmd-registry-k8s/pkg/tools/clientset"
+)
+
+// Annotation is the NSE annotation with the SPIFFE ID that registered the NSE
+const Annotation = "networkservicemesh.io/registered-by"
+
+type spiffeIDKey struct{}
+
+// WithSpiffeID returns the context annotating the NSEs written with it as registered by the SPIFFE ID
+func WithSpiffeID(ctx context.Context, spiffeID string) context.Context {
+	return context.WithValue(ctx, spiffeIDKey{}, spiffeID)
+}
+
+func spiffeIDFromContext(ctx context.Context) string {
+	spiffeID, _ := ctx.Value(spiffeIDKey{}).(string)
+	return spiffeID
+}
+
+// NewClientSet returns the client setting the Annotation of the NSEs created, updated and server-side applied with
+// a context set by WithSpiffeID, so the annotation is written together with the NSE. It must wrap the clientset
+// turning updates into server-side apply patches.
+func NewClientSet(client versioned.Interface) versioned.Interface {
+	return clientset.New(client,
+		clientset.WithNetworkServiceEndpoints(func(_ string, c nsmv1.NetworkServiceEndpointInterface) nsmv1.NetworkServiceEndpointInterface {
+			return &nseClient{
+				NetworkServiceEndpointInterface: c,
+			}
+		}),
+	)
+}
+
+type nseClient struct {
+	nsmv1.NetworkServiceEndpointInterface
+}
+
+func (c *nseClient) Create(ctx context.Context, nse *v1.NetworkServiceEndpoint, opts metav1.CreateOptions) (*v1.NetworkServiceEndpoint, error) {
+	return c.NetworkServiceEndpointInterface.Create(ctx, annotate(nse, spiffeIDFromContext(ctx)), opts)
+}
+
+func (c *nseClient) Update(ctx context.Context, nse *v1.NetworkServiceEndpoint, opts metav1.UpdateOptions) (*v1.NetworkServiceEndpoint, error) {
+	return c.NetworkServiceEndpointInterface.Update(ctx, annotate(nse, spiffeIDFromContext(ctx)), opts)
+}
+
+func (c *nseClient) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions, subresources ...string) (*v1.NetworkServiceEndpoint, error) {
+	if spiffeID := spiffeIDFromContext(ctx); spiffeID != "" && pt == types.ApplyPatchType && len(subresources) == 0 {
+		var err error
+		if data, err = annotateApplyPatch(data, spiffeID); err != nil {
+			return nil, err
+		}
+	}
+	return c.NetworkServiceEndpointInterface.Patch(ctx, name, pt, data, opts, subresources...)
+}
+
+// annotate returns a copy of the NSE with the Annotation set to the SPIFFE ID, or the NSE if the SPIFFE ID is empty
+func annotate(nse *v1.NetworkServiceEndpoint, spiffeID string) *v1.NetworkServiceEndpoint {
+	if spiffeID == "" || nse.GetAnnotations()[Annotation] == spiffeID {
+		return nse
+	}
+	nse = nse.DeepCopy()
+	if nse.Annotations == nil {
+		nse.Annotations = make(map[string]string)
+	}
+	nse.Annotations[Annotation] = spiffeID
+	return nse
+}
+
+// annotateApplyPatch returns the server-side apply patch with the Annotation set to the SPIFFE ID
+func annotateApplyPatch(data []byte, spiffeID string) ([]byte, error) {
+	var patch map[string]interface{}
+	if err := json.Unmarshal(data, &patch); err != nil {
+		return nil, errors.Wrap(err, "failed to parse the apply patch")
+	}
+	metadata, _ := patch["metadata"].(map[string]interface{})
+	if metadata == nil {
+		metadata = make(map[string]interface{})
+		patch["metadata"] = metadata
+	}
+	annotations, _ := metadata["annotations"].(map[string]interface{})
+	if annotations == nil {
+		annotations = make(map[string]interface{})
+		metadata["annotations"] = annotations
+	}
+	annotations[Annotation] = spiffeID
+	data, err := json.Marshal(patch)
+	return data, errors.Wrap(err, "failed to create the annotated apply patch")
+}
@@ -0,0 +1,38 @@
+// Copyright (c) 2026 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package debugserver
+
+import "time"
+
+type options struct {
+	readTimeout  time.Duration
+	writeTimeout time.Duration
+	idleTimeout  time.Duration
+}
+
+// Option is an option pattern for ListenAndServe
+type Option func(o *options)
+
+// WithTimeouts sets the read, write and idle timeouts of the HTTP server, 0 means no timeout. CPU profiles and traces
+// longer than the write timeout are rejected.
+func WithTimeouts(read, write, idle time.Duration) Option {
+	return func(o *options) {
+		o.readTimeout = read
+		o.writeTimeout = write
+		o.idleTimeout = idle
+	}
+}
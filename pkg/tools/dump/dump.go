@@ -0,0 +1,60 @@
+// Copyright (c) 2026 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package dump provides logging of goroutine stacks and the registry state on signals
+package dump
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"os/signal"
+	"runtime/pprof"
+
+	"github.com/networkservicemesh/sdk/pkg/tools/log"
+)
+
+// OnSignal logs stacks of all goroutines on goroutinesSignal and the JSON of the value returned by state on
+// stateSignal until ctx is done
+func OnSignal(ctx context.Context, goroutinesSignal, stateSignal os.Signal, state func() interface{}) {
+	sigCh := make(chan os.Signal, 2)
+	signal.Notify(sigCh, goroutinesSignal, stateSignal)
+	go func() {
+		defer signal.Stop(sigCh)
+		logger := log.FromContext(ctx).WithField("dump", "OnSignal")
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case sig := <-sigCh:
+				switch sig {
+				case goroutinesSignal:
+					var buf bytes.Buffer
+					_ = pprof.Lookup("goroutine").WriteTo(&buf, 2)
+					logger.Infof("received %v, goroutine stacks:\n%s", sig, buf.String())
+				case stateSignal:
+					data, err := json.MarshalIndent(state(), "", "  ")
+					if err != nil {
+						logger.Errorf("failed to marshal the registry state: %v", err.Error())
+						continue
+					}
+					logger.Infof("received %v, registry state:\n%s", sig, data)
+				}
+			}
+		}
+	}()
+}
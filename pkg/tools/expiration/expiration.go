@@ -0,0 +1,67 @@
+// Copyright (c) 2026 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package expiration provides interpretation of NSE expiration times in the strict and lenient modes
+package expiration
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Mode defines how zero and malformed expiration times are interpreted
+type Mode string
+
+const (
+	// Lenient treats zero and malformed expiration times as already expired
+	Lenient Mode = "lenient"
+	// Strict skips NSEs with zero and malformed expiration times
+	Strict Mode = "strict"
+)
+
+// ParseMode returns the Mode with the name
+func ParseMode(name string) (Mode, error) {
+	switch mode := Mode(name); mode {
+	case Lenient, Strict:
+		return mode, nil
+	default:
+		return "", errors.Errorf("unknown expiration parse mode %q, supported modes: %s, %s", name, Lenient, Strict)
+	}
+}
+
+// ExpirationTime returns the time the NSE with the expiration time expires at. It returns false if the NSE should not
+// be expired: it has no expiration time, or its expiration time is invalid in the strict mode. The returned error
+// describes the invalid expiration time in both modes.
+func (m Mode) ExpirationTime(expirationTime *timestamppb.Timestamp) (time.Time, bool, error) {
+	if expirationTime == nil {
+		return time.Time{}, false, nil
+	}
+	var err error
+	switch {
+	case expirationTime.GetSeconds() == 0 && expirationTime.GetNanos() == 0:
+		err = errors.New("expiration time is zero")
+	case expirationTime.CheckValid() != nil:
+		err = errors.Wrap(expirationTime.CheckValid(), "expiration time is malformed")
+	default:
+		return expirationTime.AsTime().Local(), true, nil
+	}
+	if m == Strict {
+		return time.Time{}, false, err
+	}
+	return time.Time{}, true, err
+}
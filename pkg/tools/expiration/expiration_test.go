@@ -0,0 +1,78 @@
+// Copyright (c) 2026 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package expiration_test
+
+import (
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/networkservicemesh/cmd-registry-k8s/pkg/tools/expiration"
+)
+
+func TestParseMode(t *testing.T) {
+	for _, name := range []string{"lenient", "strict"} {
+		if mode, err := expiration.ParseMode(name); err != nil || string(mode) != name {
+			t.Fatalf("ParseMode(%q) = %q, %v", name, mode, err)
+		}
+	}
+	if _, err := expiration.ParseMode("relaxed"); err == nil {
+		t.Fatal("unknown mode is accepted")
+	}
+}
+
+func TestMode_ExpirationTime(t *testing.T) {
+	valid := time.Now().Add(time.Minute).Truncate(time.Second)
+	for _, tc := range []struct {
+		name           string
+		expirationTime *timestamppb.Timestamp
+		// lenient and strict are whether the NSE expires in the modes
+		lenient, strict bool
+		invalid         bool
+	}{
+		{name: "valid", expirationTime: timestamppb.New(valid), lenient: true, strict: true},
+		{name: "no expiration time"},
+		{name: "zero", expirationTime: new(timestamppb.Timestamp), lenient: true, invalid: true},
+		{name: "malformed", expirationTime: &timestamppb.Timestamp{Seconds: 1, Nanos: -1}, lenient: true, invalid: true},
+		{name: "out of range", expirationTime: &timestamppb.Timestamp{Seconds: 1 << 62}, lenient: true, invalid: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			for _, mode := range []struct {
+				mode    expiration.Mode
+				expires bool
+			}{
+				{mode: expiration.Lenient, expires: tc.lenient},
+				{mode: expiration.Strict, expires: tc.strict},
+			} {
+				expirationTime, expires, err := mode.mode.ExpirationTime(tc.expirationTime)
+				if expires != mode.expires {
+					t.Fatalf("NSE expires in the %s mode: %v, want %v", mode.mode, expires, mode.expires)
+				}
+				if tc.invalid != (err != nil) {
+					t.Fatalf("unexpected error in the %s mode: %v", mode.mode, err)
+				}
+				switch {
+				case tc.invalid && expires && !expirationTime.IsZero():
+					t.Fatalf("invalid expiration time is interpreted as %v in the %s mode, want the zero time", expirationTime, mode.mode)
+				case !tc.invalid && expires && !expirationTime.Equal(valid):
+					t.Fatalf("expiration time %v in the %s mode, want %v", expirationTime, mode.mode, valid)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,49 @@
+// Copyright (c) 2026 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package expiration
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+const (
+	meterName              = "github.com/networkservicemesh/cmd-registry-k8s/pkg/tools/expiration"
+	expirationsCounterName = "registry_k8s_nse_expirations_total"
+)
+
+var expirationsCounter metric.Int64Counter
+
+func init() {
+	var err error
+	if expirationsCounter, err = otel.Meter(meterName).Int64Counter(
+		expirationsCounterName,
+		metric.WithDescription("Number of expired NSEs deleted by namespace and the deleting job"),
+	); err != nil {
+		otel.Handle(err)
+	}
+}
+
+// CountExpired records the deletion of an expired NSE from the namespace by the job, e.g. expiryqueue or gc
+func CountExpired(ctx context.Context, namespace, job string) {
+	if expirationsCounter != nil {
+		expirationsCounter.Add(ctx, 1, metric.WithAttributes(attribute.String("namespace", namespace), attribute.String("job", job)))
+	}
+}
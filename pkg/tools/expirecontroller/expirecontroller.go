@@ -0,0 +1,193 @@
+// Copyright (c) 2026 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package expirecontroller provides a controller deleting expired NetworkServiceEndpoints. NSEs are scheduled by an
+// informer into a delaying work queue at the expiration time stored in them, and each NSE is checked against the
+// informer cache when it is due, so the controller keeps no state of its own and picks all NSEs up again on restart.
+package expirecontroller
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+
+	"github.com/networkservicemesh/sdk/pkg/tools/clock"
+	"github.com/networkservicemesh/sdk/pkg/tools/log"
+
+	v1 "github.com/networkservicemesh/sdk-k8s/pkg/tools/k8s/apis/networkservicemesh.io/v1"
+	"github.com/networkservicemesh/sdk-k8s/pkg/tools/k8s/client/clientset/versioned"
+	"github.com/networkservicemesh/sdk-k8s/pkg/tools/k8s/client/informers/externalversions"
+	listersv1 "github.com/networkservicemesh/sdk-k8s/pkg/tools/k8s/client/listers/networkservicemesh.io/v1"
+
+	"github.com/networkservicemesh/cmd-registry-k8s/pkg/tools/expiration"
+)
+
+const (
+	retryInterval = time.Second
+	maxRetryDelay = time.Minute
+)
+
+type controller struct {
+	client    versioned.Interface
+	namespace string
+	mode      expiration.Mode
+	owns      func(name string) bool
+	lister    listersv1.NetworkServiceEndpointLister
+	queue     workqueue.RateLimitingInterface
+}
+
+// Run starts deleting expired NSEs from the namespace. Expiration times are interpreted according to the mode. It blocks
+// until ctx is done.
+func Run(ctx context.Context, client versioned.Interface, namespace string, mode expiration.Mode, opts ...Option) {
+	o := &options{
+		workers: 1,
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
+	logger := log.FromContext(ctx).WithField("expireController", "Run")
+
+	factory := externalversions.NewSharedInformerFactoryWithOptions(client, 0, externalversions.WithNamespace(namespace))
+	informer := factory.Networkservicemesh().V1().NetworkServiceEndpoints()
+	c := &controller{
+		client:    client,
+		namespace: namespace,
+		mode:      mode,
+		owns:      o.owns,
+		lister:    informer.Lister(),
+		queue: workqueue.NewRateLimitingQueueWithConfig(
+			workqueue.NewItemExponentialFailureRateLimiter(retryInterval, maxRetryDelay),
+			workqueue.RateLimitingQueueConfig{Name: "expirecontroller"},
+		),
+	}
+	defer c.queue.ShutDown()
+
+	if _, err := informer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			c.schedule(ctx, obj)
+		},
+		UpdateFunc: func(_, obj interface{}) {
+			c.schedule(ctx, obj)
+		},
+	}); err != nil {
+		logger.Errorf("failed to watch NSEs: %v", err.Error())
+		return
+	}
+	factory.Start(ctx.Done())
+	if !cache.WaitForCacheSync(ctx.Done(), informer.Informer().HasSynced) {
+		return
+	}
+	logger.Infof("watching NSE expiration times with %d workers", o.workers)
+
+	var wg sync.WaitGroup
+	for i := 0; i < o.workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for c.process(ctx) {
+			}
+		}()
+	}
+	<-ctx.Done()
+	c.queue.ShutDown()
+	wg.Wait()
+}
+
+// schedule adds the NSE to the queue at its expiration time
+func (c *controller) schedule(ctx context.Context, obj interface{}) {
+	nse, ok := obj.(*v1.NetworkServiceEndpoint)
+	if !ok {
+		return
+	}
+	expirationTime, ok, err := c.mode.ExpirationTime(nse.Spec.ExpirationTime)
+	if err != nil {
+		logger := log.FromContext(ctx).WithField("expireController", "schedule")
+		if ok {
+			logger.Debugf("NSE %s is treated as expired: %v", nse.GetName(), err.Error())
+		} else {
+			logger.WithField("nse_name", nse.GetName()).Warnf("NSE %s is skipped: %v", nse.GetName(), err.Error())
+		}
+	}
+	if !ok {
+		return
+	}
+	c.queue.AddAfter(nse.GetName(), clock.FromContext(ctx).Until(expirationTime))
+}
+
+// process deletes the next due NSE if it has expired according to the informer cache. It returns false when the queue
+// is shut down.
+func (c *controller) process(ctx context.Context) bool {
+	item, shutdown := c.queue.Get()
+	if shutdown {
+		return false
+	}
+	defer c.queue.Done(item)
+	name, _ := item.(string)
+	logger := log.FromContext(ctx).WithField("expireController", "process")
+
+	nse, err := c.lister.NetworkServiceEndpoints(c.namespace).Get(name)
+	if apierrors.IsNotFound(err) {
+		c.queue.Forget(item)
+		return true
+	}
+	if err != nil {
+		logger.WithField("nse_name", name).Warnf("failed to get NSE %s from the cache: %v", name, err.Error())
+		c.queue.AddRateLimited(item)
+		return true
+	}
+	expirationTime, ok, _ := c.mode.ExpirationTime(nse.Spec.ExpirationTime)
+	if !ok {
+		c.queue.Forget(item)
+		return true
+	}
+	// The NSE has been refreshed since it was scheduled
+	if remaining := clock.FromContext(ctx).Until(expirationTime); remaining > 0 {
+		c.queue.Forget(item)
+		c.queue.AddAfter(item, remaining)
+		return true
+	}
+	if c.owns != nil && !c.owns(name) {
+		// The owner deletes the NSE, the informer drops it from the cache
+		c.queue.AddAfter(item, retryInterval)
+		return true
+	}
+
+	resourceVersion := nse.GetResourceVersion()
+	err = c.client.NetworkservicemeshV1().NetworkServiceEndpoints(c.namespace).Delete(ctx, name, metav1.DeleteOptions{
+		Preconditions: &metav1.Preconditions{
+			ResourceVersion: &resourceVersion,
+		},
+	})
+	switch {
+	case err == nil:
+		logger.Infof("deleted expired NSE %s", name)
+		expiration.CountExpired(ctx, c.namespace, "expirecontroller")
+		c.queue.Forget(item)
+	case apierrors.IsNotFound(err), apierrors.IsConflict(err):
+		// The NSE has been deleted or refreshed, the informer schedules its actual state
+		logger.Debugf("skipped NSE %s: %v", name, err.Error())
+		c.queue.Forget(item)
+	default:
+		logger.WithField("nse_name", name).Warnf("failed to delete expired NSE %s: %v", name, err.Error())
+		c.queue.AddRateLimited(item)
+	}
+	return true
+}
@@ -0,0 +1,40 @@
+// Copyright (c) 2026 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package expirecontroller
+
+type options struct {
+	workers int
+	owns    func(name string) bool
+}
+
+// Option is an option pattern for Run
+type Option func(o *options)
+
+// WithWorkers sets the number of NSEs deleted concurrently
+func WithWorkers(workers int) Option {
+	return func(o *options) {
+		o.workers = workers
+	}
+}
+
+// WithOwner makes the controller delete only NSEs owns returns true for. Expired NSEs owned by someone else are checked
+// again until they are deleted, so the controller takes over NSEs of owners which have gone.
+func WithOwner(owns func(name string) bool) Option {
+	return func(o *options) {
+		o.owns = owns
+	}
+}
@@ -0,0 +1,215 @@
+// Copyright (c) 2026 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package expiryqueue provides a reaper that deletes expired NetworkServiceEndpoints exactly at their expiration time.
+// The queue is seeded by listing NSEs and then maintained by a watch, so the reaper sleeps until the next NSE expires
+// instead of periodically listing all NSEs.
+package expiryqueue
+
+import (
+	"context"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
+
+	"github.com/networkservicemesh/sdk/pkg/tools/clock"
+	"github.com/networkservicemesh/sdk/pkg/tools/log"
+
+	v1 "github.com/networkservicemesh/sdk-k8s/pkg/tools/k8s/apis/networkservicemesh.io/v1"
+	"github.com/networkservicemesh/sdk-k8s/pkg/tools/k8s/client/clientset/versioned"
+
+	"github.com/networkservicemesh/cmd-registry-k8s/pkg/tools/clientset/pagination"
+	"github.com/networkservicemesh/cmd-registry-k8s/pkg/tools/expiration"
+)
+
+const (
+	retryInterval = time.Second
+)
+
+type expiryQueue struct {
+	client    versioned.Interface
+	namespace string
+	mode      expiration.Mode
+	owns      func(name string) bool
+	pageSize  int64
+	heap      *expiryHeap
+	reported  int64
+}
+
+// Run starts deleting expired NSEs from the namespace. Expiration times are interpreted according to the mode. It blocks
+// until ctx is done.
+func Run(ctx context.Context, client versioned.Interface, namespace string, mode expiration.Mode, opts ...Option) {
+	o := new(options)
+	for _, opt := range opts {
+		opt(o)
+	}
+	q := &expiryQueue{
+		client:    client,
+		namespace: namespace,
+		mode:      mode,
+		owns:      o.owns,
+		pageSize:  o.pageSize,
+	}
+	logger := log.FromContext(ctx).WithField("expiryQueue", "Run")
+	timeClock := clock.FromContext(ctx)
+	defer func() {
+		scheduledTimers.Add(-q.reported)
+		nextExpiration.Store(0)
+	}()
+
+	for ctx.Err() == nil {
+		watcher, err := q.resync(ctx)
+		if err != nil {
+			logger.Warnf("failed to resync expiry queue: %v", err.Error())
+			select {
+			case <-ctx.Done():
+			case <-timeClock.After(retryInterval):
+			}
+			continue
+		}
+		q.serve(ctx, watcher)
+		watcher.Stop()
+	}
+}
+
+// resync rebuilds the queue from a fresh list and starts watching for changes made after the list
+func (q *expiryQueue) resync(ctx context.Context) (watch.Interface, error) {
+	nses := q.client.NetworkservicemeshV1().NetworkServiceEndpoints(q.namespace)
+	var resourceVersion string
+	if err := pagination.ListNetworkServiceEndpoints(ctx, nses, metav1.ListOptions{}, q.pageSize, func(page *v1.NetworkServiceEndpointList, first bool) error {
+		// A restarted list may miss NSEs deleted since the previous one, so the queue is rebuilt from scratch
+		if first {
+			q.heap = newExpiryHeap()
+		}
+		for i := range page.Items {
+			q.upsert(ctx, &page.Items[i])
+		}
+		resourceVersion = page.ResourceVersion
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	q.report()
+	log.FromContext(ctx).WithField("expiryQueue", "resync").Debugf("scheduled %d NSEs", q.heap.Len())
+
+	return nses.Watch(ctx, metav1.ListOptions{
+		ResourceVersion: resourceVersion,
+	})
+}
+
+func (q *expiryQueue) serve(ctx context.Context, watcher watch.Interface) {
+	timeClock := clock.FromContext(ctx)
+	timer := timeClock.Timer(0)
+	defer timer.Stop()
+
+	for {
+		if !timer.Stop() {
+			select {
+			case <-timer.C():
+			default:
+			}
+		}
+		var timerCh <-chan time.Time
+		if next, ok := q.heap.peek(); ok {
+			timer.Reset(timeClock.Until(next.expirationTime))
+			timerCh = timer.C()
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-timerCh:
+			q.reap(ctx)
+		case event, ok := <-watcher.ResultChan():
+			if !ok {
+				return
+			}
+			nse, ok := event.Object.(*v1.NetworkServiceEndpoint)
+			if !ok {
+				continue
+			}
+			if event.Type == watch.Deleted {
+				q.heap.remove(nse.GetName())
+			} else {
+				q.upsert(ctx, nse)
+			}
+		}
+		q.report()
+	}
+}
+
+// report updates the scheduled timers gauge with the current size of the queue
+func (q *expiryQueue) report() {
+	n := int64(q.heap.Len())
+	scheduledTimers.Add(n - q.reported)
+	q.reported = n
+	if next, ok := q.heap.peek(); ok {
+		nextExpiration.Store(next.expirationTime.UnixNano())
+	} else {
+		nextExpiration.Store(0)
+	}
+}
+
+func (q *expiryQueue) upsert(ctx context.Context, nse *v1.NetworkServiceEndpoint) {
+	expirationTime, ok, err := q.mode.ExpirationTime(nse.Spec.ExpirationTime)
+	if err != nil {
+		logger := log.FromContext(ctx).WithField("expiryQueue", "upsert")
+		if ok {
+			logger.Debugf("NSE %s is treated as expired: %v", nse.GetName(), err.Error())
+		} else {
+			logger.WithField("nse_name", nse.GetName()).Warnf("NSE %s is skipped: %v", nse.GetName(), err.Error())
+		}
+	}
+	if !ok {
+		q.heap.remove(nse.GetName())
+		return
+	}
+	q.heap.upsert(nse.GetName(), nse.GetResourceVersion(), expirationTime)
+}
+
+// reap deletes all NSEs whose expiration time has passed
+func (q *expiryQueue) reap(ctx context.Context) {
+	logger := log.FromContext(ctx).WithField("expiryQueue", "reap")
+	now := clock.FromContext(ctx).Now()
+
+	for next, ok := q.heap.peek(); ok && !next.expirationTime.After(now); next, ok = q.heap.peek() {
+		if q.owns != nil && !q.owns(next.name) {
+			// The owner deletes the NSE, the watch removes it from the queue
+			q.heap.upsert(next.name, next.resourceVersion, now.Add(retryInterval))
+			continue
+		}
+		err := q.client.NetworkservicemeshV1().NetworkServiceEndpoints(q.namespace).Delete(ctx, next.name, metav1.DeleteOptions{
+			Preconditions: &metav1.Preconditions{
+				ResourceVersion: &next.resourceVersion,
+			},
+		})
+		switch {
+		case err == nil:
+			logger.Infof("deleted expired NSE %s", next.name)
+			expiration.CountExpired(ctx, q.namespace, "expiryqueue")
+			q.heap.remove(next.name)
+		case apierrors.IsNotFound(err), apierrors.IsConflict(err):
+			// The NSE has been deleted or refreshed, the watch delivers its actual state
+			logger.Debugf("skipped NSE %s: %v", next.name, err.Error())
+			q.heap.remove(next.name)
+		default:
+			logger.WithField("nse_name", next.name).Warnf("failed to delete expired NSE %s: %v", next.name, err.Error())
+			q.heap.upsert(next.name, next.resourceVersion, now.Add(retryInterval))
+		}
+	}
+}
@@ -0,0 +1,166 @@
+// Copyright (c) 2026 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package expiryqueue
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	k8stesting "k8s.io/client-go/testing"
+
+	v1 "github.com/networkservicemesh/sdk-k8s/pkg/tools/k8s/apis/networkservicemesh.io/v1"
+	"github.com/networkservicemesh/sdk-k8s/pkg/tools/k8s/client/clientset/versioned/fake"
+
+	"github.com/networkservicemesh/cmd-registry-k8s/pkg/tools/expiration"
+)
+
+const (
+	namespace   = "default"
+	waitTimeout = 5 * time.Second
+)
+
+func newNSE(name string, expirationTime time.Time) *v1.NetworkServiceEndpoint {
+	nse := &v1.NetworkServiceEndpoint{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+	}
+	if !expirationTime.IsZero() {
+		nse.Spec.ExpirationTime = timestamppb.New(expirationTime)
+	}
+	return nse
+}
+
+// recordDeletes returns the function returning the names of the NSEs deleted from the client so far
+func recordDeletes(client *fake.Clientset) func() []string {
+	var mu sync.Mutex
+	var deleted []string
+	client.PrependReactor("delete", "networkserviceendpoints", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		deleted = append(deleted, action.(k8stesting.DeleteAction).GetName())
+		return false, nil, nil
+	})
+	return func() []string {
+		mu.Lock()
+		defer mu.Unlock()
+		return slices.Clone(deleted)
+	}
+}
+
+func waitForDeletes(t *testing.T, deleted func() []string, n int) []string {
+	t.Helper()
+	for start := time.Now(); time.Since(start) < waitTimeout; time.Sleep(10 * time.Millisecond) {
+		if names := deleted(); len(names) >= n {
+			return names
+		}
+	}
+	t.Fatalf("deleted NSEs %v, want %d", deleted(), n)
+	return nil
+}
+
+func TestRun_DeletesInExpirationOrder(t *testing.T) {
+	now := time.Now()
+	client := fake.NewSimpleClientset(
+		newNSE("nse-3", now.Add(300*time.Millisecond)),
+		newNSE("nse-1", now.Add(100*time.Millisecond)),
+		newNSE("nse-2", now.Add(200*time.Millisecond)),
+		newNSE("nse-no-expiration", time.Time{}),
+	)
+	deleted := recordDeletes(client)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go Run(ctx, client, namespace, expiration.Lenient)
+
+	names := waitForDeletes(t, deleted, 3)
+	if want := []string{"nse-1", "nse-2", "nse-3"}; !slices.Equal(names, want) {
+		t.Fatalf("deleted NSEs %v, want %v", names, want)
+	}
+	if _, err := client.NetworkservicemeshV1().NetworkServiceEndpoints(namespace).Get(ctx, "nse-no-expiration", metav1.GetOptions{}); err != nil {
+		t.Fatalf("NSE without expiration time is deleted: %v", err)
+	}
+}
+
+func TestRun_KeepsRefreshedNSE(t *testing.T) {
+	now := time.Now()
+	client := fake.NewSimpleClientset(
+		newNSE("nse-refreshed", now.Add(200*time.Millisecond)),
+		newNSE("nse-expired", now.Add(300*time.Millisecond)),
+	)
+	deleted := recordDeletes(client)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go Run(ctx, client, namespace, expiration.Lenient)
+
+	// The refresh is delivered by the watch and reschedules the NSE
+	time.Sleep(50 * time.Millisecond)
+	if _, err := client.NetworkservicemeshV1().NetworkServiceEndpoints(namespace).Update(ctx, newNSE("nse-refreshed", now.Add(time.Hour)), metav1.UpdateOptions{}); err != nil {
+		t.Fatal(err)
+	}
+
+	waitForDeletes(t, deleted, 1)
+	time.Sleep(100 * time.Millisecond)
+	if names := deleted(); !slices.Equal(names, []string{"nse-expired"}) {
+		t.Fatalf("deleted NSEs %v, want [nse-expired]", names)
+	}
+}
+
+func TestRun_ResyncsAfterWatchCloses(t *testing.T) {
+	client := fake.NewSimpleClientset()
+	deleted := recordDeletes(client)
+
+	// The first watch delivers no events, so the NSE is found only by the resync after it closes
+	firstWatcher := watch.NewFake()
+	watched := make(chan struct{})
+	var first atomic.Bool
+	client.PrependWatchReactor("networkserviceendpoints", func(k8stesting.Action) (bool, watch.Interface, error) {
+		if first.CompareAndSwap(false, true) {
+			close(watched)
+			return true, firstWatcher, nil
+		}
+		return false, nil, nil
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go Run(ctx, client, namespace, expiration.Lenient)
+	<-watched
+
+	if _, err := client.NetworkservicemeshV1().NetworkServiceEndpoints(namespace).Create(ctx, newNSE("nse", time.Now().Add(100*time.Millisecond)), metav1.CreateOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(200 * time.Millisecond)
+	if names := deleted(); len(names) != 0 {
+		t.Fatalf("NSEs %v are deleted without events", names)
+	}
+
+	firstWatcher.Stop()
+	if names := waitForDeletes(t, deleted, 1); !slices.Equal(names, []string{"nse"}) {
+		t.Fatalf("deleted NSEs %v, want [nse]", names)
+	}
+}
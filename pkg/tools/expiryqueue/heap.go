@@ -0,0 +1,100 @@
+// Copyright (c) 2026 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package expiryqueue
+
+import (
+	"container/heap"
+	"time"
+)
+
+type item struct {
+	name            string
+	resourceVersion string
+	expirationTime  time.Time
+	index           int
+}
+
+// expiryHeap is a min-heap of items ordered by expiration time
+type expiryHeap struct {
+	items  []*item
+	byName map[string]*item
+}
+
+func newExpiryHeap() *expiryHeap {
+	return &expiryHeap{
+		byName: make(map[string]*item),
+	}
+}
+
+func (h *expiryHeap) Len() int { return len(h.items) }
+
+func (h *expiryHeap) Less(i, j int) bool {
+	return h.items[i].expirationTime.Before(h.items[j].expirationTime)
+}
+
+func (h *expiryHeap) Swap(i, j int) {
+	h.items[i], h.items[j] = h.items[j], h.items[i]
+	h.items[i].index = i
+	h.items[j].index = j
+}
+
+func (h *expiryHeap) Push(x interface{}) {
+	it := x.(*item)
+	it.index = len(h.items)
+	h.items = append(h.items, it)
+	h.byName[it.name] = it
+}
+
+func (h *expiryHeap) Pop() interface{} {
+	n := len(h.items)
+	it := h.items[n-1]
+	h.items[n-1] = nil
+	h.items = h.items[:n-1]
+	delete(h.byName, it.name)
+	it.index = -1
+	return it
+}
+
+// upsert adds a new item or updates the existing item with the same name
+func (h *expiryHeap) upsert(name, resourceVersion string, expirationTime time.Time) {
+	if it, ok := h.byName[name]; ok {
+		it.resourceVersion = resourceVersion
+		it.expirationTime = expirationTime
+		heap.Fix(h, it.index)
+		return
+	}
+	heap.Push(h, &item{
+		name:            name,
+		resourceVersion: resourceVersion,
+		expirationTime:  expirationTime,
+	})
+}
+
+// remove deletes the item with the given name if it is present
+func (h *expiryHeap) remove(name string) {
+	if it, ok := h.byName[name]; ok {
+		heap.Remove(h, it.index)
+	}
+}
+
+// peek returns the item with the earliest expiration time
+func (h *expiryHeap) peek() (*item, bool) {
+	if len(h.items) == 0 {
+		return nil, false
+	}
+	return h.items[0], true
+}
@@ -0,0 +1,69 @@
+// Copyright (c) 2026 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package expiryqueue
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestExpiryHeap_PeekReturnsEarliest(t *testing.T) {
+	now := time.Now()
+	h := newExpiryHeap()
+	h.upsert("c", "1", now.Add(3*time.Second))
+	h.upsert("a", "1", now.Add(time.Second))
+	h.upsert("b", "1", now.Add(2*time.Second))
+
+	var names []string
+	for next, ok := h.peek(); ok; next, ok = h.peek() {
+		names = append(names, next.name)
+		h.remove(next.name)
+	}
+	if got, want := names, []string{"a", "b", "c"}; !slices.Equal(got, want) {
+		t.Fatalf("expiration order %v, want %v", got, want)
+	}
+}
+
+func TestExpiryHeap_UpsertReorders(t *testing.T) {
+	now := time.Now()
+	h := newExpiryHeap()
+	h.upsert("a", "1", now.Add(time.Second))
+	h.upsert("b", "1", now.Add(2*time.Second))
+
+	// Refresh of a moves it behind b
+	h.upsert("a", "2", now.Add(3*time.Second))
+	next, ok := h.peek()
+	if !ok || next.name != "b" {
+		t.Fatalf("next NSE %v, want b", next)
+	}
+	if h.Len() != 2 {
+		t.Fatalf("queue length %d, want 2", h.Len())
+	}
+
+	h.remove("b")
+	next, ok = h.peek()
+	if !ok || next.name != "a" || next.resourceVersion != "2" {
+		t.Fatalf("next NSE %v, want a with resource version 2", next)
+	}
+
+	h.remove("a")
+	h.remove("unknown")
+	if _, ok := h.peek(); ok {
+		t.Fatal("queue is not empty")
+	}
+}
@@ -0,0 +1,47 @@
+// Copyright (c) 2026 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package expiryqueue
+
+import (
+	"context"
+	"sync/atomic"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/metric"
+)
+
+const (
+	meterName                = "github.com/networkservicemesh/cmd-registry-k8s/pkg/tools/expiryqueue"
+	scheduledTimersGaugeName = "registry_k8s_scheduled_expiry_timers"
+)
+
+// scheduledTimers is the number of NSE expirations currently scheduled by all running queues
+var scheduledTimers atomic.Int64
+
+func init() {
+	_, err := otel.Meter(meterName).Int64ObservableGauge(
+		scheduledTimersGaugeName,
+		metric.WithDescription("Number of NSE expiry timers currently scheduled by the expiry queue"),
+		metric.WithInt64Callback(func(_ context.Context, o metric.Int64Observer) error {
+			o.Observe(scheduledTimers.Load())
+			return nil
+		}),
+	)
+	if err != nil {
+		otel.Handle(err)
+	}
+}
@@ -0,0 +1,43 @@
+// Copyright (c) 2026 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package expiryqueue
+
+import (
+	"sync/atomic"
+	"time"
+)
+
+// nextExpiration is the earliest expiration time scheduled by the last reporting queue in Unix nanoseconds, or 0
+var nextExpiration atomic.Int64
+
+// State is a point in time summary of the running expiry queues
+type State struct {
+	Scheduled      int64      `json:"scheduled"`
+	NextExpiration *time.Time `json:"nextExpiration,omitempty"`
+}
+
+// CurrentState returns the number of scheduled NSE expirations and the earliest of them
+func CurrentState() State {
+	s := State{
+		Scheduled: scheduledTimers.Load(),
+	}
+	if next := nextExpiration.Load(); next != 0 {
+		t := time.Unix(0, next)
+		s.NextExpiration = &t
+	}
+	return s
+}
@@ -0,0 +1,150 @@
+// Copyright (c) 2026 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package filesource provides an X.509 SVID and trust bundle source loading them from files instead of the SPIFFE
+// Workload API. The files are reloaded when they change, so rotated certificates are picked up without restart.
+package filesource
+
+import (
+	"context"
+	"path/filepath"
+	"sync"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/pkg/errors"
+	"github.com/spiffe/go-spiffe/v2/bundle/x509bundle"
+	"github.com/spiffe/go-spiffe/v2/spiffeid"
+	"github.com/spiffe/go-spiffe/v2/svid/x509svid"
+
+	"github.com/networkservicemesh/sdk/pkg/tools/log"
+)
+
+// Source is an X.509 SVID and trust bundle source backed by PEM files
+type Source struct {
+	certFile, keyFile, bundleFile string
+
+	mu     sync.RWMutex
+	svid   *x509svid.SVID
+	bundle *x509bundle.Bundle
+}
+
+// New loads the SVID from the certificate and key files and the CA certificates from the bundle file, and reloads
+// them on changes of the files until ctx is done. The certificate must have a SPIFFE ID URI SAN. The CA certificates
+// are trusted for every trust domain.
+func New(ctx context.Context, certFile, keyFile, bundleFile string) (*Source, error) {
+	s := &Source{
+		certFile:   certFile,
+		keyFile:    keyFile,
+		bundleFile: bundleFile,
+	}
+	if err := s.load(); err != nil {
+		return nil, err
+	}
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create a file watcher")
+	}
+	// Directories are watched instead of files, because mounted secrets are updated by swapping symlinks
+	dirs := map[string]struct{}{}
+	for _, file := range []string{certFile, keyFile, bundleFile} {
+		dirs[filepath.Dir(file)] = struct{}{}
+	}
+	for dir := range dirs {
+		if err := watcher.Add(dir); err != nil {
+			_ = watcher.Close()
+			return nil, errors.Wrapf(err, "failed to watch %s", dir)
+		}
+	}
+	go s.watch(ctx, watcher)
+
+	return s, nil
+}
+
+// GetX509SVID returns the last loaded SVID
+func (s *Source) GetX509SVID() (*x509svid.SVID, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.svid, nil
+}
+
+// GetX509BundleForTrustDomain returns the last loaded CA certificates as the bundle of the trust domain
+func (s *Source) GetX509BundleForTrustDomain(trustDomain spiffeid.TrustDomain) (*x509bundle.Bundle, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return x509bundle.FromX509Authorities(trustDomain, s.bundle.X509Authorities()), nil
+}
+
+func (s *Source) load() error {
+	svid, err := x509svid.Load(s.certFile, s.keyFile)
+	if err != nil {
+		return errors.Wrapf(err, "failed to load the SVID from %s and %s", s.certFile, s.keyFile)
+	}
+	bundle, err := x509bundle.Load(svid.ID.TrustDomain(), s.bundleFile)
+	if err != nil {
+		return errors.Wrapf(err, "failed to load the CA bundle from %s", s.bundleFile)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.svid = svid
+	s.bundle = bundle
+	return nil
+}
+
+func (s *Source) watch(ctx context.Context, watcher *fsnotify.Watcher) {
+	logger := log.FromContext(ctx).WithField("filesource", "watch")
+	defer func() { _ = watcher.Close() }()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event, ok := <-watcher.Events:
+			if !ok {
+				return
+			}
+			if !s.watched(event.Name) {
+				continue
+			}
+			// Files written in several steps fail to load until the last write, the last event reloads them
+			if err := s.load(); err != nil {
+				logger.Warnf("failed to reload TLS files, keeping the previous ones: %v", err.Error())
+				continue
+			}
+			logger.Infof("TLS files reloaded on %s", event)
+		case err, ok := <-watcher.Errors:
+			if !ok {
+				return
+			}
+			logger.Warnf("file watcher error: %v", err.Error())
+		}
+	}
+}
+
+// watched returns true if the changed file may affect the loaded files. Mounted secrets change the ..data symlink and
+// the directories it points to rather than the files themselves.
+func (s *Source) watched(name string) bool {
+	for _, file := range []string{s.certFile, s.keyFile, s.bundleFile} {
+		if filepath.Clean(name) == filepath.Clean(file) {
+			return true
+		}
+	}
+	return filepath.Base(name) == "..data"
+}
@@ -0,0 +1,181 @@
+// Copyright (c) 2026 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package gc provides a garbage collector that periodically deletes expired NetworkServiceEndpoints. It relies only on
+// expiration times stored in the NSEs, so NSEs left behind by a restarted registry are still deleted.
+package gc
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/networkservicemesh/sdk/pkg/tools/clock"
+	"github.com/networkservicemesh/sdk/pkg/tools/log"
+
+	v1 "github.com/networkservicemesh/sdk-k8s/pkg/tools/k8s/apis/networkservicemesh.io/v1"
+	"github.com/networkservicemesh/sdk-k8s/pkg/tools/k8s/client/clientset/versioned"
+
+	"github.com/networkservicemesh/cmd-registry-k8s/pkg/tools/clientset/pagination"
+	"github.com/networkservicemesh/cmd-registry-k8s/pkg/tools/expiration"
+	"github.com/networkservicemesh/cmd-registry-k8s/pkg/tools/retrypolicy"
+)
+
+const (
+	defaultDeleteTimeout = 10 * time.Second
+)
+
+// Run sweeps expired NSEs from the namespace on start, unless WithDelayedStart is set, and then every interval. Expiration times are interpreted
+// according to the mode. With metav1.NamespaceAll, NSEs of all namespaces listed by the client are swept. It blocks
+// until ctx is done.
+func Run(ctx context.Context, client versioned.Interface, namespace string, interval time.Duration, mode expiration.Mode, opts ...Option) {
+	g := newCollector(client, namespace, mode, opts...)
+
+	timeClock := clock.FromContext(ctx)
+	ticker := timeClock.Ticker(interval)
+	defer ticker.Stop()
+
+	if g.delayedStart {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C():
+		}
+	}
+	for {
+		g.sweep(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C():
+		}
+	}
+}
+
+// Sweep deletes the NSEs of the namespace expired by now once, like a single sweep of Run
+func Sweep(ctx context.Context, client versioned.Interface, namespace string, mode expiration.Mode, opts ...Option) {
+	newCollector(client, namespace, mode, opts...).sweep(ctx)
+}
+
+func newCollector(client versioned.Interface, namespace string, mode expiration.Mode, opts ...Option) *collector {
+	o := &options{
+		workers:       1,
+		deleteTimeout: defaultDeleteTimeout,
+		retryPolicy:   &retrypolicy.Default,
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return &collector{
+		client:    client,
+		namespace: namespace,
+		mode:      mode,
+		options:   o,
+	}
+}
+
+type collector struct {
+	client    versioned.Interface
+	namespace string
+	mode      expiration.Mode
+	*options
+}
+
+// summary counts the outcomes of a sweep
+type summary struct {
+	deleted, kept, skipped, failed atomic.Int32
+}
+
+// sweep deletes all NSEs expired by now using the worker pool. The NSEs are listed page by page, each page is handed
+// to the workers before the next one is listed. Deletes are guarded by the listed ResourceVersion, so NSEs refreshed in
+// the meantime are kept.
+func (g *collector) sweep(ctx context.Context) {
+	logger := log.FromContext(ctx).WithField("gc", "sweep")
+	start := time.Now()
+	now := clock.FromContext(ctx).Now()
+	var s summary
+	expired := make(chan *v1.NetworkServiceEndpoint)
+	var wg sync.WaitGroup
+	for i := 0; i < g.workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for nse := range expired {
+				g.delete(ctx, nse, &s)
+			}
+		}()
+	}
+	listed := 0
+	err := pagination.ListNetworkServiceEndpoints(ctx, g.client.NetworkservicemeshV1().NetworkServiceEndpoints(g.namespace), metav1.ListOptions{}, g.pageSize,
+		func(page *v1.NetworkServiceEndpointList, _ bool) error {
+			listed += len(page.Items)
+			for i := range page.Items {
+				nse := &page.Items[i]
+				expirationTime, ok, err := g.mode.ExpirationTime(nse.Spec.ExpirationTime)
+				if err != nil && !ok {
+					logger.WithField("nse_name", nse.GetName()).Warnf("NSE %s is skipped: %v", nse.GetName(), err.Error())
+					s.skipped.Add(1)
+				}
+				if !ok || expirationTime.After(now) || (g.owns != nil && !g.owns(nse.GetName())) {
+					continue
+				}
+				expired <- nse
+			}
+			return nil
+		})
+	close(expired)
+	wg.Wait()
+	if err != nil {
+		logger.Warnf("failed to list NSEs: %v", err.Error())
+	}
+
+	if s.deleted.Load() > 0 || s.failed.Load() > 0 || s.skipped.Load() > 0 {
+		logger.Infof("swept %d NSEs in %v: deleted %d, kept %d, skipped %d, failed %d", listed, time.Since(start),
+			s.deleted.Load(), s.kept.Load(), s.skipped.Load(), s.failed.Load())
+	}
+}
+
+// delete deletes the expired NSE, retrying with backoff until the delete timeout. NSEs deleted or refreshed by someone
+// else are kept.
+func (g *collector) delete(ctx context.Context, nse *v1.NetworkServiceEndpoint, s *summary) {
+	deleteCtx, cancel := context.WithTimeout(ctx, g.deleteTimeout)
+	defer cancel()
+
+	nses := g.client.NetworkservicemeshV1().NetworkServiceEndpoints(nse.GetNamespace())
+	err := g.retryPolicy.Retry(deleteCtx, func(err error) bool {
+		return !apierrors.IsNotFound(err) && !apierrors.IsConflict(err)
+	}, func() error {
+		return nses.Delete(deleteCtx, nse.GetName(), metav1.DeleteOptions{
+			Preconditions: &metav1.Preconditions{
+				ResourceVersion: &nse.ResourceVersion,
+			},
+		})
+	})
+	switch {
+	case err == nil:
+		s.deleted.Add(1)
+		expiration.CountExpired(ctx, nse.GetNamespace(), "gc")
+	case apierrors.IsNotFound(err), apierrors.IsConflict(err):
+		s.kept.Add(1)
+	default:
+		s.failed.Add(1)
+		log.FromContext(ctx).WithField("gc", "delete").WithField("nse_name", nse.GetName()).Warnf("failed to delete expired NSE %s: %v", nse.GetName(), err.Error())
+	}
+}
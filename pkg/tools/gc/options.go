@@ -0,0 +1,77 @@
+// Copyright (c) 2026 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gc
+
+import (
+	"time"
+
+	"github.com/networkservicemesh/cmd-registry-k8s/pkg/tools/retrypolicy"
+)
+
+type options struct {
+	workers       int
+	deleteTimeout time.Duration
+	retryPolicy   *retrypolicy.Policy
+	owns          func(name string) bool
+	pageSize      int64
+	delayedStart  bool
+}
+
+// Option is an option pattern for Run
+type Option func(o *options)
+
+// WithWorkers sets the number of NSEs deleted concurrently by a sweep
+func WithWorkers(workers int) Option {
+	return func(o *options) {
+		o.workers = workers
+	}
+}
+
+// WithDeleteTimeout sets the timeout of each NSE delete including its retries
+func WithDeleteTimeout(deleteTimeout time.Duration) Option {
+	return func(o *options) {
+		o.deleteTimeout = deleteTimeout
+	}
+}
+
+// WithRetryPolicy sets the delays between retries of each NSE delete
+func WithRetryPolicy(policy *retrypolicy.Policy) Option {
+	return func(o *options) {
+		o.retryPolicy = policy
+	}
+}
+
+// WithOwner makes sweeps delete only NSEs owns returns true for
+func WithOwner(owns func(name string) bool) Option {
+	return func(o *options) {
+		o.owns = owns
+	}
+}
+
+// WithPageSize makes sweeps list NSEs in pages of pageSize NSEs and process them page by page
+func WithPageSize(pageSize int64) Option {
+	return func(o *options) {
+		o.pageSize = pageSize
+	}
+}
+
+// WithDelayedStart makes Run wait for the interval before the first sweep, e.g. when the caller has just swept
+func WithDelayedStart() Option {
+	return func(o *options) {
+		o.delayedStart = true
+	}
+}
@@ -0,0 +1,145 @@
+// Copyright (c) 2026 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package grpclisten provides ListenAndServe for gRPC servers with limits of the listener
+package grpclisten
+
+import (
+	"context"
+	"net"
+	"net/url"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/pkg/errors"
+	"golang.org/x/net/netutil"
+	"google.golang.org/grpc"
+
+	"github.com/networkservicemesh/sdk/pkg/tools/grpcutils"
+)
+
+const (
+	unixScheme = "unix"
+
+	// staleDialTimeout bounds the check whether a server accepts connections on an existing socket
+	staleDialTimeout = time.Second
+)
+
+// ListenAndServe listens on address with server until ctx is done, like grpcutils.ListenAndServe, with the listener
+// limited by options. Besides unix and tcp, which listens on both IPv4 and IPv6 for an empty or unspecified host, the
+// tcp4 and tcp6 schemes listen on a single IP family. The address is updated to the address of the listener, e.g. with the port chosen for port 0.
+// The returned channel receives the listen or serve error and is closed when the listener stops.
+func ListenAndServe(ctx context.Context, address *url.URL, server *grpc.Server, options ...Option) <-chan error {
+	o := &listenOptions{
+		socketMode:    os.ModePerm,
+		socketUID:     -1,
+		socketGID:     -1,
+		socketCleanup: CleanupAlways,
+	}
+	for _, opt := range options {
+		opt(o)
+	}
+
+	errCh := make(chan error, 1)
+	ln, err := listen(address, o)
+	if err != nil {
+		errCh <- err
+		close(errCh)
+		return errCh
+	}
+	*address = *grpcutils.AddressToURL(ln.Addr())
+	if o.maxConnections > 0 {
+		ln = netutil.LimitListener(ln, o.maxConnections)
+	}
+
+	go func() {
+		<-ctx.Done()
+		server.Stop()
+	}()
+	go func() {
+		defer close(errCh)
+		defer func() { _ = ln.Close() }()
+		if err := server.Serve(ln); err != nil {
+			errCh <- err
+		}
+	}()
+	return errCh
+}
+
+func listen(address *url.URL, o *listenOptions) (net.Listener, error) {
+	if address.Scheme != unixScheme {
+		network := "tcp"
+		if address.Scheme == "tcp4" || address.Scheme == "tcp6" {
+			network = address.Scheme
+		}
+		ln, err := net.Listen(network, address.Host)
+		return ln, errors.Wrapf(err, "failed to listen on %s", address.String())
+	}
+
+	path := address.Path
+	if path == "" {
+		path = address.Opaque
+	}
+	if err := cleanup(path, o.socketCleanup); err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), os.ModePerm); err != nil {
+		return nil, errors.Wrapf(err, "failed to create the socket directory of %s", path)
+	}
+	ln, err := net.Listen(unixScheme, path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to listen on %s", address.String())
+	}
+	if err := os.Chmod(path, o.socketMode); err != nil {
+		_ = ln.Close()
+		return nil, errors.Wrapf(err, "failed to change the mode of %s", path)
+	}
+	if o.socketUID != -1 || o.socketGID != -1 {
+		if err := os.Chown(path, o.socketUID, o.socketGID); err != nil {
+			_ = ln.Close()
+			return nil, errors.Wrapf(err, "failed to change the owner of %s", path)
+		}
+	}
+	return ln, nil
+}
+
+// cleanup removes the existing socket file at path according to the cleanup mode
+func cleanup(path, mode string) error {
+	info, err := os.Lstat(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return errors.Wrapf(err, "failed to check the existing socket %s", path)
+	}
+	switch mode {
+	case CleanupNever:
+		return errors.Errorf("socket %s already exists", path)
+	case CleanupStale:
+		if info.Mode().Type() != os.ModeSocket {
+			return errors.Errorf("%s already exists and is not a socket", path)
+		}
+		if conn, dialErr := net.DialTimeout(unixScheme, path, staleDialTimeout); dialErr == nil {
+			_ = conn.Close()
+			return errors.Errorf("socket %s is in use by another server", path)
+		}
+	}
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return errors.Wrapf(err, "failed to remove the existing socket %s", path)
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+// Copyright (c) 2026 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpclisten
+
+import "os"
+
+const (
+	// CleanupAlways removes an existing socket file before listening
+	CleanupAlways = "always"
+	// CleanupStale removes an existing socket file only if no server accepts connections on it
+	CleanupStale = "stale"
+	// CleanupNever fails to listen if the socket file exists
+	CleanupNever = "never"
+)
+
+type listenOptions struct {
+	maxConnections int
+	socketMode     os.FileMode
+	socketUID      int
+	socketGID      int
+	socketCleanup  string
+}
+
+// Option is an option pattern for ListenAndServe
+type Option func(o *listenOptions)
+
+// WithMaxConnections limits the number of simultaneous connections of the listener. Further connections wait in the
+// backlog of the listener until others are closed. 0 disables the limit.
+func WithMaxConnections(maxConnections int) Option {
+	return func(o *listenOptions) {
+		o.maxConnections = maxConnections
+	}
+}
+
+// WithSocketMode sets the file mode of unix sockets, os.ModePerm by default
+func WithSocketMode(mode os.FileMode) Option {
+	return func(o *listenOptions) {
+		o.socketMode = mode
+	}
+}
+
+// WithSocketOwner sets the owner and group of unix sockets, -1 keeps the owner or group of the registry process
+func WithSocketOwner(uid, gid int) Option {
+	return func(o *listenOptions) {
+		o.socketUID = uid
+		o.socketGID = gid
+	}
+}
+
+// WithSocketCleanup sets when an existing unix socket file is removed: CleanupAlways, the default, CleanupStale or
+// CleanupNever
+func WithSocketCleanup(cleanup string) Option {
+	return func(o *listenOptions) {
+		o.socketCleanup = cleanup
+	}
+}
@@ -0,0 +1,333 @@
+// Copyright (c) 2026 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package handoff provides handing the expiration of NSEs registered on a stopping registry replica over to the other
+// replicas. The stopping replica annotates its NSEs as released, another replica adopts each released NSE and deletes
+// it at its expiration time unless it is refreshed before. NSEs managed by replicas which have crashed are adopted the
+// same way.
+package handoff
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
+
+	"github.com/networkservicemesh/sdk/pkg/tools/clock"
+	"github.com/networkservicemesh/sdk/pkg/tools/log"
+
+	v1 "github.com/networkservicemesh/sdk-k8s/pkg/tools/k8s/apis/networkservicemesh.io/v1"
+	"github.com/networkservicemesh/sdk-k8s/pkg/tools/k8s/client/clientset/versioned"
+
+	"github.com/networkservicemesh/cmd-registry-k8s/pkg/tools/clientset/managedby"
+	"github.com/networkservicemesh/cmd-registry-k8s/pkg/tools/expiration"
+)
+
+const (
+	// ReleasedByAnnotation is set to the identity of the replica which released the NSE on exit
+	ReleasedByAnnotation = "networkservicemesh.io/released-by"
+	// AdoptedByAnnotation is set to the identity of the replica which adopted the released NSE
+	AdoptedByAnnotation = "networkservicemesh.io/adopted-by"
+
+	releaseWorkers = 8
+	retryInterval  = time.Second
+)
+
+// Handoff releases the NSEs of the replica on exit and adopts the NSEs released by other replicas or by previous runs
+// of the replica
+type Handoff struct {
+	client    versioned.Interface
+	namespace string
+	identity  string
+	mode      expiration.Mode
+	alive     func(identity string) bool
+	resync    time.Duration
+
+	mu      sync.Mutex
+	adopted map[string]*adoptedNSE
+}
+
+type adoptedNSE struct {
+	resourceVersion string
+	timer           clock.Timer
+}
+
+// New returns the handoff of the replica with the identity for the NSEs in the namespace. Expiration times of adopted
+// NSEs are interpreted according to the mode.
+func New(client versioned.Interface, namespace, identity string, mode expiration.Mode, opts ...Option) *Handoff {
+	o := new(options)
+	for _, opt := range opts {
+		opt(o)
+	}
+	return &Handoff{
+		client:    client,
+		namespace: namespace,
+		identity:  identity,
+		mode:      mode,
+		alive:     o.alive,
+		resync:    o.resyncInterval,
+		adopted:   make(map[string]*adoptedNSE),
+	}
+}
+
+// Release annotates the NSEs with the names and the NSEs adopted by the replica as released by it, so other replicas
+// adopt them. It returns when all NSEs are annotated or ctx is done.
+func (h *Handoff) Release(ctx context.Context, names []string) {
+	logger := log.FromContext(ctx).WithField("handoff", "Release")
+
+	h.mu.Lock()
+	for name, nse := range h.adopted {
+		nse.timer.Stop()
+		names = append(names, name)
+	}
+	h.adopted = make(map[string]*adoptedNSE)
+	h.mu.Unlock()
+
+	patch, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"annotations": map[string]interface{}{
+				ReleasedByAnnotation: h.identity,
+				AdoptedByAnnotation:  nil,
+			},
+		},
+	})
+	if err != nil {
+		logger.Errorf("failed to marshal the release patch: %v", err.Error())
+		return
+	}
+
+	nameCh := make(chan string)
+	var released atomic.Int64
+	var wg sync.WaitGroup
+	for i := 0; i < releaseWorkers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for name := range nameCh {
+				_, err := h.client.NetworkservicemeshV1().NetworkServiceEndpoints(h.namespace).Patch(ctx, name, types.MergePatchType, patch, metav1.PatchOptions{})
+				switch {
+				case err == nil:
+					released.Add(1)
+				case apierrors.IsNotFound(err):
+					// The NSE has expired or has been unregistered
+				default:
+					logger.WithField("nse_name", name).Warnf("failed to release NSE %s: %v", name, err.Error())
+				}
+			}
+		}()
+	}
+	for _, name := range names {
+		if ctx.Err() != nil {
+			break
+		}
+		nameCh <- name
+	}
+	close(nameCh)
+	wg.Wait()
+	logger.Infof("released %d of %d NSEs", released.Load(), len(names))
+}
+
+// Run adopts the released NSEs until ctx is done. Release must be called after ctx is done, so the replica doesn't adopt
+// the NSEs it releases.
+func (h *Handoff) Run(ctx context.Context) {
+	logger := log.FromContext(ctx).WithField("handoff", "Run")
+	timeClock := clock.FromContext(ctx)
+
+	for first := true; ctx.Err() == nil; {
+		watcher, err := h.list(ctx, first)
+		if err != nil {
+			logger.Warnf("failed to list released NSEs: %v", err.Error())
+			select {
+			case <-ctx.Done():
+			case <-timeClock.After(retryInterval):
+			}
+			continue
+		}
+		first = false
+		h.serve(ctx, watcher)
+		watcher.Stop()
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for _, nse := range h.adopted {
+		nse.timer.Stop()
+	}
+}
+
+// list adopts the released NSEs of a fresh list and starts watching for changes made after the list. The first list
+// after the start also adopts the NSEs managed by the identity of the replica, which are left by its previous run, e.g.
+// of a StatefulSet pod with the same name.
+func (h *Handoff) list(ctx context.Context, first bool) (watch.Interface, error) {
+	nses := h.client.NetworkservicemeshV1().NetworkServiceEndpoints(h.namespace)
+	list, err := nses.List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for i := range list.Items {
+		nse := &list.Items[i]
+		if first && h.alive != nil && nse.GetAnnotations()[managedby.Annotation] == h.identity {
+			h.adopt(ctx, nse, "managed by the previous run of "+h.identity)
+			continue
+		}
+		h.handle(ctx, nse)
+	}
+	return nses.Watch(ctx, metav1.ListOptions{
+		ResourceVersion: list.ResourceVersion,
+	})
+}
+
+// serve handles the watch events until the watch is closed or the next resync is due
+func (h *Handoff) serve(ctx context.Context, watcher watch.Interface) {
+	var resyncCh <-chan time.Time
+	if h.resync > 0 {
+		resyncCh = clock.FromContext(ctx).After(h.resync)
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-resyncCh:
+			return
+		case event, ok := <-watcher.ResultChan():
+			if !ok {
+				return
+			}
+			nse, ok := event.Object.(*v1.NetworkServiceEndpoint)
+			if !ok {
+				continue
+			}
+			if event.Type == watch.Deleted {
+				h.forget(nse.GetName(), "")
+				continue
+			}
+			h.handle(ctx, nse)
+		}
+	}
+}
+
+// handle forgets the adopted NSE if it has changed since the adoption, e.g. because it has been refreshed on another
+// replica which tracks its expiration now, and adopts the NSE if it is released or its manager is gone
+func (h *Handoff) handle(ctx context.Context, nse *v1.NetworkServiceEndpoint) {
+	h.forget(nse.GetName(), nse.GetResourceVersion())
+
+	// NSEs released by the identity of the replica are adopted too, they are left by its previous run
+	if releasedBy := nse.GetAnnotations()[ReleasedByAnnotation]; releasedBy != "" {
+		h.adopt(ctx, nse, "released by "+releasedBy)
+		return
+	}
+	manager := nse.GetAnnotations()[managedby.Annotation]
+	if h.alive != nil && manager != "" && manager != h.identity && !h.alive(manager) {
+		h.adopt(ctx, nse, "managed by "+manager+", which is gone")
+	}
+}
+
+// adopt takes the expiration of the NSE over, unless another replica has adopted it first
+func (h *Handoff) adopt(ctx context.Context, nse *v1.NetworkServiceEndpoint, reason string) {
+	logger := log.FromContext(ctx).WithField("handoff", "adopt").WithField("nse_name", nse.GetName())
+
+	// The resource version makes the patch fail if another replica has adopted the NSE first
+	patch, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"resourceVersion": nse.GetResourceVersion(),
+			"annotations": map[string]interface{}{
+				ReleasedByAnnotation: nil,
+				AdoptedByAnnotation:  h.identity,
+				managedby.Annotation: h.identity,
+			},
+		},
+	})
+	if err != nil {
+		logger.Errorf("failed to marshal the adopt patch: %v", err.Error())
+		return
+	}
+	adopted, err := h.client.NetworkservicemeshV1().NetworkServiceEndpoints(h.namespace).Patch(ctx, nse.GetName(), types.MergePatchType, patch, metav1.PatchOptions{})
+	switch {
+	case apierrors.IsConflict(err), apierrors.IsNotFound(err):
+		logger.Debugf("NSE %s has been adopted by another replica, refreshed or deleted: %v", nse.GetName(), err.Error())
+		return
+	case err != nil:
+		logger.Warnf("failed to adopt NSE %s %s: %v", nse.GetName(), reason, err.Error())
+		return
+	}
+	expirationTime, ok, _ := h.mode.ExpirationTime(adopted.Spec.ExpirationTime)
+	if !ok {
+		logger.Infof("adopted NSE %s %s, it has no valid expiration time", nse.GetName(), reason)
+		return
+	}
+	logger.Infof("adopted NSE %s %s, it expires at %v", nse.GetName(), reason, expirationTime)
+	h.schedule(ctx, adopted.GetName(), adopted.GetResourceVersion(), clock.FromContext(ctx).Until(expirationTime))
+}
+
+// schedule deletes the adopted NSE with the resource version after the duration
+func (h *Handoff) schedule(ctx context.Context, name, resourceVersion string, d time.Duration) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.adopted[name] = &adoptedNSE{
+		resourceVersion: resourceVersion,
+		timer: clock.FromContext(ctx).AfterFunc(d, func() {
+			h.expire(ctx, name, resourceVersion)
+		}),
+	}
+}
+
+// forget stops tracking the expiration of the adopted NSE unless it still has the resource version
+func (h *Handoff) forget(name, resourceVersion string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if nse, ok := h.adopted[name]; ok && nse.resourceVersion != resourceVersion {
+		nse.timer.Stop()
+		delete(h.adopted, name)
+	}
+}
+
+// expire deletes the adopted NSE unless it has been refreshed since the adoption
+func (h *Handoff) expire(ctx context.Context, name, resourceVersion string) {
+	h.mu.Lock()
+	nse, ok := h.adopted[name]
+	if !ok || nse.resourceVersion != resourceVersion {
+		h.mu.Unlock()
+		return
+	}
+	delete(h.adopted, name)
+	h.mu.Unlock()
+
+	logger := log.FromContext(ctx).WithField("handoff", "expire")
+	err := h.client.NetworkservicemeshV1().NetworkServiceEndpoints(h.namespace).Delete(ctx, name, metav1.DeleteOptions{
+		Preconditions: &metav1.Preconditions{
+			ResourceVersion: &resourceVersion,
+		},
+	})
+	switch {
+	case err == nil:
+		logger.Infof("deleted expired adopted NSE %s", name)
+	case apierrors.IsNotFound(err), apierrors.IsConflict(err):
+		logger.Debugf("skipped adopted NSE %s: %v", name, err.Error())
+	default:
+		logger.WithField("nse_name", name).Warnf("failed to delete expired adopted NSE %s: %v", name, err.Error())
+		if ctx.Err() == nil {
+			h.schedule(ctx, name, resourceVersion, retryInterval)
+		}
+	}
+}
@@ -0,0 +1,37 @@
+// Copyright (c) 2026 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handoff
+
+import "time"
+
+type options struct {
+	alive          func(identity string) bool
+	resyncInterval time.Duration
+}
+
+// Option is an option pattern for New
+type Option func(o *options)
+
+// WithLiveness makes the replica adopt NSEs managed by replicas alive returns false for, e.g. because they have
+// crashed without releasing their NSEs. NSEs are listed again every resyncInterval to find the NSEs of replicas which
+// have gone since the last list.
+func WithLiveness(alive func(identity string) bool, resyncInterval time.Duration) Option {
+	return func(o *options) {
+		o.alive = alive
+		o.resyncInterval = resyncInterval
+	}
+}
@@ -0,0 +1,165 @@
+// Copyright (c) 2026 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package health provides the gRPC health service reporting readiness of the registry subsystems
+package health
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+
+	"github.com/networkservicemesh/api/pkg/api/registry"
+
+	registryserver "github.com/networkservicemesh/sdk/pkg/registry"
+	"github.com/networkservicemesh/sdk/pkg/tools/clock"
+	"github.com/networkservicemesh/sdk/pkg/tools/log"
+)
+
+const (
+	// NetworkServiceEndpoints is the health service name of the NSE registry subsystem
+	NetworkServiceEndpoints = "registry.nse"
+	// NetworkServices is the health service name of the NS registry subsystem
+	NetworkServices = "registry.ns"
+
+	checkInterval = time.Second
+)
+
+// Server reports serving status of the registry subsystems. Each subsystem is reported under its health service name
+// and under the names of its gRPC services. The overall "" service is serving when all subsystems are serving.
+type Server struct {
+	healthServer *health.Server
+	services     map[string][]string
+	serving      map[string]bool
+	overall      bool
+	started      bool
+	shutdown     bool
+	mu           sync.Mutex
+}
+
+// Register registers the registry services and the health service on the servers. All subsystems start as
+// NOT_SERVING.
+func Register(r registryserver.Registry, servers ...*grpc.Server) *Server {
+	nsServer, nseServer := r.NetworkServiceRegistryServer(), r.NetworkServiceEndpointRegistryServer()
+
+	healthServer := &Server{
+		healthServer: health.NewServer(),
+		services: map[string][]string{
+			NetworkServices:         append([]string{NetworkServices}, registry.ServiceNames(nsServer)...),
+			NetworkServiceEndpoints: append([]string{NetworkServiceEndpoints}, registry.ServiceNames(nseServer)...),
+		},
+		serving: make(map[string]bool),
+	}
+	for _, s := range servers {
+		registry.RegisterNetworkServiceRegistryServer(s, nsServer)
+		registry.RegisterNetworkServiceEndpointRegistryServer(s, nseServer)
+		grpc_health_v1.RegisterHealthServer(s, healthServer.healthServer)
+	}
+	for subsystem := range healthServer.services {
+		healthServer.setStatus(subsystem, false)
+	}
+	healthServer.healthServer.SetServingStatus("", grpc_health_v1.HealthCheckResponse_NOT_SERVING)
+
+	return healthServer
+}
+
+// SetServing sets serving status of the subsystem
+func (s *Server) SetServing(subsystem string, serving bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.setStatus(subsystem, serving)
+
+	s.overall = true
+	for name := range s.services {
+		s.overall = s.overall && s.serving[name]
+	}
+	s.started = s.started || s.overall
+	overall := grpc_health_v1.HealthCheckResponse_NOT_SERVING
+	if s.overall {
+		overall = grpc_health_v1.HealthCheckResponse_SERVING
+	}
+	s.healthServer.SetServingStatus("", overall)
+}
+
+// Serving returns true if all subsystems are serving
+func (s *Server) Serving() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.overall && !s.shutdown
+}
+
+// Started returns true if all subsystems have been serving at least once
+func (s *Server) Started() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.started
+}
+
+// Monitor runs check for the subsystem until ctx is done and sets its serving status to the result. Until the first
+// success check is retried every second, after that it runs every interval. Status changes are logged.
+func (s *Server) Monitor(ctx context.Context, subsystem string, interval time.Duration, check func(context.Context) error) {
+	logger := log.FromContext(ctx).WithField("health", subsystem)
+	timeClock := clock.FromContext(ctx)
+	ready, serving := false, false
+	for {
+		err := check(ctx)
+		switch {
+		case err == nil && !serving:
+			logger.Infof("%s is serving", subsystem)
+		case err != nil && (serving || !ready):
+			logger.Warnf("%s is not serving: %v", subsystem, err.Error())
+		}
+		if serving != (err == nil) {
+			serving = err == nil
+			s.SetServing(subsystem, serving)
+		}
+		ready = ready || serving
+
+		delay := interval
+		if !ready {
+			delay = checkInterval
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-timeClock.After(delay):
+		}
+	}
+}
+
+// Shutdown sets all services as NOT_SERVING and ignores further status updates
+func (s *Server) Shutdown() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.shutdown = true
+	s.healthServer.Shutdown()
+}
+
+func (s *Server) setStatus(subsystem string, serving bool) {
+	status := grpc_health_v1.HealthCheckResponse_NOT_SERVING
+	if serving {
+		status = grpc_health_v1.HealthCheckResponse_SERVING
+	}
+	s.serving[subsystem] = serving
+	for _, name := range s.services[subsystem] {
+		s.healthServer.SetServingStatus(name, status)
+	}
+}
@@ -0,0 +1,102 @@
+// Copyright (c) 2026 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package heartbeat provides a heartbeat NSE refreshed by the registry, so external tooling can monitor the registry
+// liveness by watching the NSE expiration time
+package heartbeat
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/networkservicemesh/sdk/pkg/tools/clock"
+	"github.com/networkservicemesh/sdk/pkg/tools/log"
+
+	v1 "github.com/networkservicemesh/sdk-k8s/pkg/tools/k8s/apis/networkservicemesh.io/v1"
+	"github.com/networkservicemesh/sdk-k8s/pkg/tools/k8s/client/clientset/versioned"
+	nsmv1 "github.com/networkservicemesh/sdk-k8s/pkg/tools/k8s/client/clientset/versioned/typed/networkservicemesh.io/v1"
+)
+
+const (
+	// Label is the label set on heartbeat NSEs
+	Label = "networkservicemesh.io/registry-heartbeat"
+
+	// expirationIntervals is the number of refresh intervals the heartbeat NSE stays valid for
+	expirationIntervals = 3
+	deleteTimeout       = 5 * time.Second
+)
+
+// Run refreshes the heartbeat NSE with the name in the namespace every interval. The NSE expires after three missed
+// refreshes. Run blocks until ctx is done and deletes the NSE on exit.
+func Run(ctx context.Context, client versioned.Interface, namespace, name string, interval time.Duration) {
+	logger := log.FromContext(ctx).WithField("heartbeat", name)
+	timeClock := clock.FromContext(ctx)
+	nses := client.NetworkservicemeshV1().NetworkServiceEndpoints(namespace)
+
+	defer func() {
+		deleteCtx, cancel := context.WithTimeout(context.Background(), deleteTimeout)
+		defer cancel()
+		if err := nses.Delete(deleteCtx, name, metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+			logger.Warnf("failed to delete heartbeat NSE: %v", err.Error())
+		}
+	}()
+
+	startTime := timestamppb.New(timeClock.Now())
+	ticker := timeClock.Ticker(interval)
+	defer ticker.Stop()
+	for {
+		if err := refresh(ctx, nses, &v1.NetworkServiceEndpoint{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: namespace,
+				Labels:    map[string]string{Label: "true"},
+			},
+			Spec: v1.NetworkServiceEndpointSpec{
+				Name:                    name,
+				InitialRegistrationTime: startTime,
+				ExpirationTime:          timestamppb.New(timeClock.Now().Add(expirationIntervals * interval)),
+			},
+		}); err != nil {
+			logger.Warnf("failed to refresh heartbeat NSE: %v", err.Error())
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C():
+		}
+	}
+}
+
+func refresh(ctx context.Context, nses nsmv1.NetworkServiceEndpointInterface, nse *v1.NetworkServiceEndpoint) error {
+	_, err := nses.Create(ctx, nse, metav1.CreateOptions{})
+	if !apierrors.IsAlreadyExists(err) {
+		return err
+	}
+	existing, err := nses.Get(ctx, nse.GetName(), metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	existing.Labels = nse.Labels
+	nse.Spec.DeepCopyInto(&existing.Spec)
+	existing.Spec.InitialRegistrationTime = nse.Spec.InitialRegistrationTime
+	_, err = nses.Update(ctx, existing, metav1.UpdateOptions{})
+	return err
+}
@@ -0,0 +1,71 @@
+// Copyright (c) 2026 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package ipurl provides validation and normalization of IP literals in URLs, e.g. of IPv6 NSE URLs
+package ipurl
+
+import (
+	"net"
+	"net/url"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Normalize validates the host of u and rewrites an IPv6 literal to its canonical bracketed form, e.g.
+// tcp://[2001:db8:0:0::1]:5001 to tcp://[2001:db8::1]:5001. IPv6 literals without brackets are rejected, because their
+// port can't be told apart from the address. URLs without a host, e.g. of unix sockets, are kept as they are.
+func Normalize(u *url.URL) error {
+	if u.Host == "" {
+		return nil
+	}
+	host, port, err := net.SplitHostPort(u.Host)
+	if err != nil {
+		if strings.Count(u.Host, ":") > 1 && !strings.HasPrefix(u.Host, "[") {
+			return errors.Errorf("IPv6 address in %s must be enclosed in brackets, e.g. [%s]:<port>", u.String(), u.Host)
+		}
+		host, port = strings.TrimSuffix(strings.TrimPrefix(u.Host, "["), "]"), ""
+	}
+
+	address, zone, _ := strings.Cut(host, "%")
+	ip := net.ParseIP(address)
+	// IPv4 and IPv4-mapped IPv6 addresses are kept as they are
+	if ip == nil || ip.To4() != nil {
+		return nil
+	}
+	host = ip.String()
+	if zone != "" {
+		host += "%" + zone
+	}
+	if port == "" {
+		u.Host = "[" + host + "]"
+		return nil
+	}
+	u.Host = net.JoinHostPort(host, port)
+	return nil
+}
+
+// NormalizeString is Normalize for raw URLs
+func NormalizeString(rawURL string) (string, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to parse URL %s", rawURL)
+	}
+	if err := Normalize(u); err != nil {
+		return "", err
+	}
+	return u.String(), nil
+}
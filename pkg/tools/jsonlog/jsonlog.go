@@ -0,0 +1,105 @@
+// Copyright (c) 2026 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package jsonlog provides a logrus formatter writing entries as JSON objects with consistent field names
+package jsonlog
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	nseNameField      = "nse_name"
+	namespaceField    = "namespace"
+	spanField         = "span"
+	chainElementField = "chain_element"
+
+	// spanSuffix starts the span appended to the messages of the trace loggers
+	spanSuffix = " span="
+)
+
+// dataFields are the fields which are kept as they are. Any other field follows the convention of naming the field by
+// the chain element or the package logging the entry and setting it to the method, so it becomes chain_element.
+var dataFields = map[string]bool{
+	"cmd":              true,
+	"type":             true,
+	"id":               true,
+	"name":             true,
+	"event":            true,
+	"pid":              true,
+	"reason":           true,
+	"duration_seconds": true,
+	"uptime_seconds":   true,
+	"repeated":         true,
+	nseNameField:       true,
+	namespaceField:     true,
+	spanField:          true,
+	chainElementField:  true,
+	logrus.ErrorKey:    true,
+}
+
+// Formatter writes entries as single line JSON objects with time, level, msg, namespace and, when known, span,
+// chain_element and nse_name fields
+type Formatter struct {
+	json      logrus.JSONFormatter
+	namespace string
+}
+
+// New returns the formatter adding namespace to all entries
+func New(namespace string) *Formatter {
+	return &Formatter{
+		namespace: namespace,
+	}
+}
+
+// Format formats the entry as a JSON object
+func (f *Formatter) Format(entry *logrus.Entry) ([]byte, error) {
+	data := make(logrus.Fields, len(entry.Data)+2)
+	var elements []string
+	for k, v := range entry.Data {
+		if dataFields[k] {
+			data[k] = v
+			continue
+		}
+		elements = append(elements, fmt.Sprintf("%s.%v", k, v))
+	}
+	if len(elements) > 0 {
+		sort.Strings(elements)
+		data[chainElementField] = strings.Join(elements, ",")
+	}
+	data[namespaceField] = f.namespace
+
+	message := entry.Message
+	if i := strings.LastIndex(message, spanSuffix); i >= 0 {
+		data[spanField] = message[i+len(spanSuffix):]
+		message = message[:i]
+	}
+	if entry.Context != nil {
+		if spanContext := trace.SpanContextFromContext(entry.Context); spanContext.IsValid() {
+			data[spanField] = spanContext.SpanID().String()
+		}
+	}
+
+	formatted := *entry
+	formatted.Data = data
+	formatted.Message = message
+	return f.json.Format(&formatted)
+}
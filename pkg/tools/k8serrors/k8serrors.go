@@ -0,0 +1,67 @@
+// Copyright (c) 2026 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package k8serrors provides gRPC server interceptors mapping Kubernetes API errors to gRPC status codes
+package k8serrors
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+// UnaryServerInterceptor returns the interceptor converting Kubernetes API errors returned by handlers to gRPC statuses
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		resp, err := handler(ctx, req)
+		return resp, ToStatus(err)
+	}
+}
+
+// StreamServerInterceptor returns the interceptor converting Kubernetes API errors returned by handlers to gRPC statuses
+func StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return ToStatus(handler(srv, ss))
+	}
+}
+
+// ToStatus converts a Kubernetes API error to the gRPC status error with the matching code. Errors which already
+// carry a gRPC status and errors without a matching code are returned as is.
+func ToStatus(err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	var code codes.Code
+	switch {
+	case apierrors.IsConflict(err):
+		code = codes.Aborted
+	case apierrors.IsNotFound(err):
+		code = codes.NotFound
+	case apierrors.IsTooManyRequests(err):
+		code = codes.ResourceExhausted
+	case apierrors.IsForbidden(err):
+		code = codes.PermissionDenied
+	default:
+		return err
+	}
+	return status.Error(code, err.Error())
+}
@@ -0,0 +1,88 @@
+// Copyright (c) 2026 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package k8serrors_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/networkservicemesh/cmd-registry-k8s/pkg/tools/k8serrors"
+)
+
+var nses = schema.GroupResource{Group: "networkservicemesh.io", Resource: "networkserviceendpoints"}
+
+func TestToStatus(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		err  error
+		code codes.Code
+	}{
+		{name: "conflict", err: apierrors.NewConflict(nses, "nse", errors.New("changed")), code: codes.Aborted},
+		{name: "not found", err: apierrors.NewNotFound(nses, "nse"), code: codes.NotFound},
+		{name: "too many requests", err: apierrors.NewTooManyRequests("throttled", 1), code: codes.ResourceExhausted},
+		{name: "forbidden", err: apierrors.NewForbidden(nses, "nse", errors.New("denied")), code: codes.PermissionDenied},
+		{name: "wrapped conflict", err: errors.Wrap(apierrors.NewConflict(nses, "nse", errors.New("changed")), "failed to update"), code: codes.Aborted},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			s, ok := status.FromError(k8serrors.ToStatus(tc.err))
+			if !ok {
+				t.Fatalf("%v is not converted to a gRPC status", tc.err)
+			}
+			if s.Code() != tc.code {
+				t.Fatalf("%v is converted to %v, want %v", tc.err, s.Code(), tc.code)
+			}
+			if s.Message() != tc.err.Error() {
+				t.Fatalf("status message %q, want %q", s.Message(), tc.err.Error())
+			}
+		})
+	}
+}
+
+func TestToStatus_Unmapped(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		err  error
+	}{
+		{name: "nil"},
+		{name: "gRPC status", err: status.Error(codes.InvalidArgument, "invalid")},
+		{name: "bad request", err: apierrors.NewBadRequest("bad")},
+		{name: "plain error", err: errors.New("failed")},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if err := k8serrors.ToStatus(tc.err); err != tc.err {
+				t.Fatalf("%v is converted to %v", tc.err, err)
+			}
+		})
+	}
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	interceptor := k8serrors.UnaryServerInterceptor()
+	_, err := interceptor(context.Background(), nil, &grpc.UnaryServerInfo{}, func(context.Context, interface{}) (interface{}, error) {
+		return nil, apierrors.NewNotFound(nses, "nse")
+	})
+	if status.Code(err) != codes.NotFound {
+		t.Fatalf("handler error is converted to %v, want %v", status.Code(err), codes.NotFound)
+	}
+}
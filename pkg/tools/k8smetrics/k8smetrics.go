@@ -0,0 +1,201 @@
+// Copyright (c) 2026 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package k8smetrics provides OpenTelemetry metrics of the k8s API usage by the registry
+package k8smetrics
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/metrics"
+
+	"github.com/networkservicemesh/sdk/pkg/tools/clock"
+	"github.com/networkservicemesh/sdk/pkg/tools/log"
+
+	"github.com/networkservicemesh/sdk-k8s/pkg/tools/k8s/client/clientset/versioned"
+
+	"github.com/networkservicemesh/cmd-registry-k8s/pkg/tools/expiration"
+)
+
+const (
+	meterName = "github.com/networkservicemesh/cmd-registry-k8s/pkg/tools/k8smetrics"
+
+	countTimeout = 5 * time.Second
+)
+
+// RegisterClientMetrics records latencies, rate limiter delays and results of all k8s client requests:
+//   - registry_k8s_client_request_duration_seconds by verb
+//   - registry_k8s_client_rate_limiter_duration_seconds by verb
+//   - registry_k8s_client_requests_total by method and code
+//   - registry_k8s_api_errors_total of failed requests by namespace, method and code
+//
+// It must be called before creating the clients.
+func RegisterClientMetrics(namespace string) {
+	meter := otel.Meter(meterName)
+	requestLatency, err := meter.Float64Histogram("registry_k8s_client_request_duration_seconds",
+		metric.WithDescription("Duration of k8s API requests by verb"),
+		metric.WithUnit("s"))
+	if err != nil {
+		otel.Handle(err)
+		return
+	}
+	rateLimiterLatency, err := meter.Float64Histogram("registry_k8s_client_rate_limiter_duration_seconds",
+		metric.WithDescription("Time k8s API requests wait for the client rate limiter by verb"),
+		metric.WithUnit("s"))
+	if err != nil {
+		otel.Handle(err)
+		return
+	}
+	requestResult, err := meter.Int64Counter("registry_k8s_client_requests_total",
+		metric.WithDescription("Number of k8s API requests by method and response code"))
+	if err != nil {
+		otel.Handle(err)
+		return
+	}
+	apiErrors, err := meter.Int64Counter("registry_k8s_api_errors_total",
+		metric.WithDescription("Number of failed k8s API requests by namespace, method and response code"))
+	if err != nil {
+		otel.Handle(err)
+		return
+	}
+
+	metrics.Register(metrics.RegisterOpts{
+		RequestLatency:     &latencyMetric{histogram: requestLatency},
+		RateLimiterLatency: &latencyMetric{histogram: rateLimiterLatency},
+		RequestResult:      &resultMetric{counter: requestResult, errors: apiErrors, namespace: namespace},
+	})
+}
+
+// RegisterObjectCounts reports the numbers of NSEs and NSs in the namespace as registry_k8s_nses and registry_k8s_nss.
+// Each observation is a single item list, counting the rest of objects by the remaining item count, so it needs no
+// paging; the lists go through the rate limiter of the client like all requests. A count is skipped with a warning while
+// the previous one hasn't finished, e.g. when concurrent scrapes pile up on a slow API server.
+func RegisterObjectCounts(client versioned.Interface, namespace string, opts ...Option) {
+	o := new(options)
+	for _, opt := range opts {
+		opt(o)
+	}
+	meter := otel.Meter(meterName)
+	var nseTTL metric.Float64Histogram
+	if o.nseTTL {
+		var err error
+		if nseTTL, err = meter.Float64Histogram("registry_k8s_nse_ttl_seconds",
+			metric.WithDescription("Time left until the expiration of NSEs in the registry namespace, recorded on each NSE count"),
+			metric.WithUnit("s"),
+			metric.WithExplicitBucketBoundaries(0, 5, 10, 15, 30, 60, 120, 300, 600, 1800, 3600)); err != nil {
+			otel.Handle(err)
+			return
+		}
+	}
+	var nsesRunning, nssRunning atomic.Bool
+	if _, err := meter.Int64ObservableGauge("registry_k8s_nses",
+		metric.WithDescription("Number of NSEs in the registry namespace"),
+		metric.WithInt64Callback(func(ctx context.Context, o metric.Int64Observer) error {
+			if !nsesRunning.CompareAndSwap(false, true) {
+				log.FromContext(ctx).Warnf("Skipping the NSE count of namespace %s, the previous one hasn't finished", namespace)
+				return nil
+			}
+			defer nsesRunning.Store(false)
+			ctx, cancel := context.WithTimeout(ctx, countTimeout)
+			defer cancel()
+			list, err := client.NetworkservicemeshV1().NetworkServiceEndpoints(namespace).List(ctx, metav1.ListOptions{Limit: 1})
+			if err != nil {
+				return err
+			}
+			o.Observe(count(len(list.Items), list.RemainingItemCount))
+			if nseTTL != nil {
+				return recordNSETTL(ctx, client, namespace, nseTTL)
+			}
+			return nil
+		}),
+	); err != nil {
+		otel.Handle(err)
+	}
+	if _, err := meter.Int64ObservableGauge("registry_k8s_nss",
+		metric.WithDescription("Number of NSs in the registry namespace"),
+		metric.WithInt64Callback(func(ctx context.Context, o metric.Int64Observer) error {
+			if !nssRunning.CompareAndSwap(false, true) {
+				log.FromContext(ctx).Warnf("Skipping the NS count of namespace %s, the previous one hasn't finished", namespace)
+				return nil
+			}
+			defer nssRunning.Store(false)
+			ctx, cancel := context.WithTimeout(ctx, countTimeout)
+			defer cancel()
+			list, err := client.NetworkservicemeshV1().NetworkServices(namespace).List(ctx, metav1.ListOptions{Limit: 1})
+			if err != nil {
+				return err
+			}
+			o.Observe(count(len(list.Items), list.RemainingItemCount))
+			return nil
+		}),
+	); err != nil {
+		otel.Handle(err)
+	}
+}
+
+// recordNSETTL records the time left until the expiration of each NSE with a valid expiration time. The list without
+// options is served from the informer cache.
+func recordNSETTL(ctx context.Context, client versioned.Interface, namespace string, nseTTL metric.Float64Histogram) error {
+	list, err := client.NetworkservicemeshV1().NetworkServiceEndpoints(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+	now := clock.FromContext(ctx).Now()
+	for i := range list.Items {
+		if expiresAt, ok, _ := expiration.Strict.ExpirationTime(list.Items[i].Spec.ExpirationTime); ok {
+			nseTTL.Record(ctx, expiresAt.Sub(now).Seconds())
+		}
+	}
+	return nil
+}
+
+func count(items int, remaining *int64) int64 {
+	if remaining == nil {
+		return int64(items)
+	}
+	return int64(items) + *remaining
+}
+
+type latencyMetric struct {
+	histogram metric.Float64Histogram
+}
+
+func (m *latencyMetric) Observe(ctx context.Context, verb string, _ url.URL, latency time.Duration) {
+	m.histogram.Record(ctx, latency.Seconds(), metric.WithAttributes(attribute.String("verb", verb)))
+}
+
+type resultMetric struct {
+	counter   metric.Int64Counter
+	errors    metric.Int64Counter
+	namespace string
+}
+
+// Increment counts the request. Failed requests have a 4xx or 5xx code, or "<error>" if there is no response.
+func (m *resultMetric) Increment(ctx context.Context, code, method, _ string) {
+	m.counter.Add(ctx, 1, metric.WithAttributes(attribute.String("method", method), attribute.String("code", code)))
+	if strings.HasPrefix(code, "4") || strings.HasPrefix(code, "5") || code == "<error>" {
+		m.errors.Add(ctx, 1, metric.WithAttributes(
+			attribute.String("namespace", m.namespace), attribute.String("method", method), attribute.String("code", code)))
+	}
+}
@@ -0,0 +1,145 @@
+// Copyright (c) 2026 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package k8smetrics
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "github.com/networkservicemesh/sdk-k8s/pkg/tools/k8s/apis/networkservicemesh.io/v1"
+	"github.com/networkservicemesh/sdk-k8s/pkg/tools/k8s/client/clientset/versioned/fake"
+	nsmv1 "github.com/networkservicemesh/sdk-k8s/pkg/tools/k8s/client/clientset/versioned/typed/networkservicemesh.io/v1"
+
+	"github.com/networkservicemesh/cmd-registry-k8s/pkg/tools/clientset"
+)
+
+func TestRegisterObjectCounts_NSETTL(t *testing.T) {
+	reader := sdkmetric.NewManualReader()
+	otel.SetMeterProvider(sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader)))
+
+	now := time.Now()
+	nse := func(name string, expirationTime *timestamppb.Timestamp) *v1.NetworkServiceEndpoint {
+		return &v1.NetworkServiceEndpoint{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "ns"},
+			Spec:       v1.NetworkServiceEndpointSpec{Name: name, ExpirationTime: expirationTime},
+		}
+	}
+	client := fake.NewSimpleClientset(
+		nse("soon", timestamppb.New(now.Add(20*time.Second))),
+		nse("later", timestamppb.New(now.Add(10*time.Minute))),
+		nse("no-expiration", nil),
+		nse("zero", new(timestamppb.Timestamp)),
+	)
+	RegisterObjectCounts(client, "ns", WithNSETTL())
+
+	var rm metricdata.ResourceMetrics
+	if err := reader.Collect(context.Background(), &rm); err != nil {
+		t.Fatal(err)
+	}
+	var nses int64
+	var ttl *metricdata.HistogramDataPoint[float64]
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			switch data := m.Data.(type) {
+			case metricdata.Gauge[int64]:
+				if m.Name == "registry_k8s_nses" {
+					nses = data.DataPoints[0].Value
+				}
+			case metricdata.Histogram[float64]:
+				if m.Name == "registry_k8s_nse_ttl_seconds" {
+					ttl = &data.DataPoints[0]
+				}
+			}
+		}
+	}
+	if nses != 4 {
+		t.Fatalf("registry_k8s_nses is %d, want 4", nses)
+	}
+	if ttl == nil {
+		t.Fatal("registry_k8s_nse_ttl_seconds is not recorded")
+	}
+	if ttl.Count != 2 {
+		t.Fatalf("registry_k8s_nse_ttl_seconds counts %d NSEs, want the 2 with valid expiration times", ttl.Count)
+	}
+	if low, ok := ttl.Min.Value(); !ok || low < 15 || low > 20 {
+		t.Fatalf("registry_k8s_nse_ttl_seconds min is %v, want about 20", low)
+	}
+	if high, ok := ttl.Max.Value(); !ok || high < 595 || high > 600 {
+		t.Fatalf("registry_k8s_nse_ttl_seconds max is %v, want about 600", high)
+	}
+}
+
+// blockingNSEs blocks the first NSE list until release is closed
+type blockingNSEs struct {
+	nsmv1.NetworkServiceEndpointInterface
+	listed  chan struct{}
+	release chan struct{}
+}
+
+func (b *blockingNSEs) List(ctx context.Context, opts metav1.ListOptions) (*v1.NetworkServiceEndpointList, error) {
+	select {
+	case b.listed <- struct{}{}:
+		<-b.release
+	default:
+	}
+	return b.NetworkServiceEndpointInterface.List(ctx, opts)
+}
+
+func TestRegisterObjectCounts_SkipOverrun(t *testing.T) {
+	// Each reader collects on its own, so a slow count can overlap with the count of another reader
+	first, second := sdkmetric.NewManualReader(), sdkmetric.NewManualReader()
+	otel.SetMeterProvider(sdkmetric.NewMeterProvider(sdkmetric.WithReader(first), sdkmetric.WithReader(second)))
+
+	listed := make(chan struct{})
+	release := make(chan struct{})
+	client := clientset.New(fake.NewSimpleClientset(),
+		clientset.WithNetworkServiceEndpoints(func(_ string, c nsmv1.NetworkServiceEndpointInterface) nsmv1.NetworkServiceEndpointInterface {
+			return &blockingNSEs{NetworkServiceEndpointInterface: c, listed: listed, release: release}
+		}),
+	)
+	RegisterObjectCounts(client, "ns")
+
+	done := make(chan error, 1)
+	go func() {
+		done <- first.Collect(context.Background(), new(metricdata.ResourceMetrics))
+	}()
+	<-listed
+
+	var rm metricdata.ResourceMetrics
+	if err := second.Collect(context.Background(), &rm); err != nil {
+		t.Fatal(err)
+	}
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			if m.Name == "registry_k8s_nses" {
+				t.Fatal("NSEs are counted while the previous count hasn't finished")
+			}
+		}
+	}
+
+	close(release)
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+}
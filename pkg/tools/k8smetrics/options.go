@@ -0,0 +1,33 @@
+// Copyright (c) 2026 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package k8smetrics
+
+type options struct {
+	nseTTL bool
+}
+
+// Option is an option pattern for RegisterObjectCounts
+type Option func(o *options)
+
+// WithNSETTL makes RegisterObjectCounts also record the time left until the expiration of each NSE as
+// registry_k8s_nse_ttl_seconds whenever the NSEs are counted. It lists all NSEs, so the client should serve lists from
+// the informer cache.
+func WithNSETTL() Option {
+	return func(o *options) {
+		o.nseTTL = true
+	}
+}
@@ -0,0 +1,58 @@
+// Copyright (c) 2026 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package kubeconfig provides the config of the Kubernetes API client in and out of the cluster
+package kubeconfig
+
+import (
+	"os"
+	"strings"
+
+	"github.com/pkg/errors"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+// serviceAccountNamespaceFile is mounted into pods together with the service account token
+const serviceAccountNamespaceFile = "/var/run/secrets/kubernetes.io/serviceaccount/namespace"
+
+// Load returns the client config and the namespace of the registry. If neither the kubeconfig path nor the context is
+// set, the in-cluster config is used, falling back to the kubeconfig of the KUBECONFIG env variable or ~/.kube/config
+// out of the cluster. In the cluster the namespace is the one of the service account, empty if it is not mounted, out
+// of the cluster it is the one of the kubeconfig context.
+func Load(path, context string) (*rest.Config, string, error) {
+	if path == "" && context == "" {
+		if config, err := rest.InClusterConfig(); err == nil {
+			namespace, _ := os.ReadFile(serviceAccountNamespaceFile)
+			return config, strings.TrimSpace(string(namespace)), nil
+		}
+	}
+
+	loadingRules := clientcmd.NewDefaultClientConfigLoadingRules()
+	loadingRules.ExplicitPath = path
+	clientConfig := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(loadingRules, &clientcmd.ConfigOverrides{
+		CurrentContext: context,
+	})
+	config, err := clientConfig.ClientConfig()
+	if err != nil {
+		return nil, "", errors.Wrap(err, "failed to load the kubeconfig, the registry is not running in a cluster")
+	}
+	namespace, _, err := clientConfig.Namespace()
+	if err != nil {
+		return nil, "", errors.Wrap(err, "failed to get the namespace of the kubeconfig context")
+	}
+	return config, namespace, nil
+}
@@ -0,0 +1,121 @@
+// Copyright (c) 2026 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package leader provides Lease based leader election for the registry jobs which must run in a single replica
+package leader
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/leaderelection"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+
+	"github.com/networkservicemesh/sdk/pkg/tools/log"
+)
+
+// Run campaigns for the Lease with the name in the namespace as identity and runs lead while this replica is the
+// leader, after the warmup set by WithWarmup. lead must return when its context is done. After losing the leadership
+// the replica campaigns again. The Lease is released when ctx is done. It blocks until ctx is done.
+func Run(ctx context.Context, client kubernetes.Interface, namespace, name, identity string, lead func(ctx context.Context), opts ...Option) error {
+	o := &options{
+		leaseDuration: 15 * time.Second,
+		renewDeadline: 10 * time.Second,
+		retryPeriod:   2 * time.Second,
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
+	logger := log.FromContext(ctx).WithField("leader", name)
+
+	elector, err := leaderelection.NewLeaderElector(leaderelection.LeaderElectionConfig{
+		Lock: &resourcelock.LeaseLock{
+			LeaseMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: namespace,
+			},
+			Client: client.CoordinationV1(),
+			LockConfig: resourcelock.ResourceLockConfig{
+				Identity: identity,
+			},
+		},
+		Name:            name,
+		LeaseDuration:   o.leaseDuration,
+		RenewDeadline:   o.renewDeadline,
+		RetryPeriod:     o.retryPeriod,
+		ReleaseOnCancel: true,
+		Callbacks: leaderelection.LeaderCallbacks{
+			OnStartedLeading: func(leadCtx context.Context) {
+				logger.WithField("event", "leader_acquired").Infof("%s became the leader", identity)
+				if o.warmup != nil {
+					start := time.Now()
+					o.warmup(leadCtx)
+					logger.WithField("event", "leader_warmup").Infof("warmup completed in %v", time.Since(start))
+				}
+				lead(leadCtx)
+			},
+			OnStoppedLeading: func() {
+				logger.Infof("%s stopped leading", identity)
+			},
+			OnNewLeader: func(leader string) {
+				if leader != identity {
+					logger.Infof("%s is the leader", leader)
+				}
+			},
+		},
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to create a leader elector for the lease %s/%s", namespace, name)
+	}
+
+	for ctx.Err() == nil {
+		elector.Run(ctx)
+	}
+	return nil
+}
+
+// CheckAccess returns an error if the registry isn't allowed to get, create and update Leases in the namespace, which
+// leader election needs
+func CheckAccess(ctx context.Context, client kubernetes.Interface, namespace string) error {
+	var missing []string
+	for _, verb := range []string{"get", "create", "update"} {
+		review, err := client.AuthorizationV1().SelfSubjectAccessReviews().Create(ctx, &authorizationv1.SelfSubjectAccessReview{
+			Spec: authorizationv1.SelfSubjectAccessReviewSpec{
+				ResourceAttributes: &authorizationv1.ResourceAttributes{
+					Namespace: namespace,
+					Verb:      verb,
+					Group:     "coordination.k8s.io",
+					Resource:  "leases",
+				},
+			},
+		}, metav1.CreateOptions{})
+		if err != nil {
+			return errors.Wrapf(err, "failed to review the permission to %s leases in namespace %s", verb, namespace)
+		}
+		if !review.Status.Allowed {
+			missing = append(missing, verb)
+		}
+	}
+	if len(missing) > 0 {
+		return errors.Errorf("missing RBAC permissions for leases.coordination.k8s.io in namespace %s: %s", namespace, strings.Join(missing, ", "))
+	}
+	return nil
+}
@@ -0,0 +1,123 @@
+// Copyright (c) 2026 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package leader_test
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	authorizationv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	"github.com/networkservicemesh/cmd-registry-k8s/pkg/tools/leader"
+)
+
+const leaseNamespace = "kube-system"
+
+// allowVerbs makes the client allow only the verbs on leases of the namespace
+func allowVerbs(client *fake.Clientset, verbs ...string) {
+	client.PrependReactor("create", "selfsubjectaccessreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		review := action.(k8stesting.CreateAction).GetObject().(*authorizationv1.SelfSubjectAccessReview)
+		attributes := review.Spec.ResourceAttributes
+		for _, verb := range verbs {
+			if attributes.Verb == verb && attributes.Namespace == leaseNamespace && attributes.Resource == "leases" {
+				review.Status.Allowed = true
+			}
+		}
+		return true, review, nil
+	})
+}
+
+func TestCheckAccess(t *testing.T) {
+	client := fake.NewSimpleClientset()
+	allowVerbs(client, "get", "create", "update")
+	if err := leader.CheckAccess(context.Background(), client, leaseNamespace); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCheckAccess_Missing(t *testing.T) {
+	client := fake.NewSimpleClientset()
+	allowVerbs(client, "get")
+	err := leader.CheckAccess(context.Background(), client, leaseNamespace)
+	if err == nil || !strings.HasSuffix(err.Error(), "in namespace kube-system: create, update") {
+		t.Fatalf("missing create and update permissions are not reported: %v", err)
+	}
+}
+
+func TestRun_WarmupBeforeLead(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	client := fake.NewSimpleClientset()
+
+	var mu sync.Mutex
+	var calls []string
+	record := func(call string) {
+		mu.Lock()
+		defer mu.Unlock()
+		calls = append(calls, call)
+	}
+	leading := make(chan struct{})
+	done := make(chan error, 1)
+	go func() {
+		done <- leader.Run(ctx, client, leaseNamespace, "registry-k8s-leader", "replica-1",
+			func(ctx context.Context) {
+				record("lead")
+				close(leading)
+				<-ctx.Done()
+			},
+			leader.WithWarmup(func(context.Context) {
+				record("warmup")
+			}),
+			leader.WithLeaseDuration(3*time.Second),
+			leader.WithRenewDeadline(2*time.Second),
+			leader.WithRetryPeriod(100*time.Millisecond))
+	}()
+
+	select {
+	case <-leading:
+	case <-time.After(5 * time.Second):
+		t.Fatal("the only replica hasn't become the leader")
+	}
+	lease, err := client.CoordinationV1().Leases(leaseNamespace).Get(ctx, "registry-k8s-leader", metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if holder := lease.Spec.HolderIdentity; holder == nil || *holder != "replica-1" {
+		t.Fatalf("Lease holder %v, want replica-1", holder)
+	}
+
+	cancel()
+	select {
+	case err = <-done:
+		if err != nil {
+			t.Fatal(err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Run hasn't returned after the context is done")
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if got := strings.Join(calls, ","); got != "warmup,lead" {
+		t.Fatalf("calls %s, want warmup,lead", got)
+	}
+}
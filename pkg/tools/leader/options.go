@@ -0,0 +1,61 @@
+// Copyright (c) 2026 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package leader
+
+import (
+	"context"
+	"time"
+)
+
+type options struct {
+	leaseDuration time.Duration
+	renewDeadline time.Duration
+	retryPeriod   time.Duration
+	warmup        func(ctx context.Context)
+}
+
+// Option is an option pattern for Run
+type Option func(o *options)
+
+// WithLeaseDuration sets the time non-leaders wait before taking over a Lease which hasn't been renewed
+func WithLeaseDuration(leaseDuration time.Duration) Option {
+	return func(o *options) {
+		o.leaseDuration = leaseDuration
+	}
+}
+
+// WithRenewDeadline sets the time the leader keeps retrying to renew the Lease before giving up the leadership
+func WithRenewDeadline(renewDeadline time.Duration) Option {
+	return func(o *options) {
+		o.renewDeadline = renewDeadline
+	}
+}
+
+// WithRetryPeriod sets the time between attempts to acquire or renew the Lease
+func WithRetryPeriod(retryPeriod time.Duration) Option {
+	return func(o *options) {
+		o.retryPeriod = retryPeriod
+	}
+}
+
+// WithWarmup makes a replica run warmup each time it becomes the leader, before lead, e.g. to catch up on work the
+// previous leader missed before it lost the Lease. warmup must return when its context is done.
+func WithWarmup(warmup func(ctx context.Context)) Option {
+	return func(o *options) {
+		o.warmup = warmup
+	}
+}
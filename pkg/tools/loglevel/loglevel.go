@@ -0,0 +1,129 @@
+// Copyright (c) 2026 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package loglevel provides changing the log level at runtime with an HTTP endpoint or a watched file, e.g. mounted
+// from a ConfigMap
+package loglevel
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+
+	"github.com/networkservicemesh/sdk/pkg/tools/log"
+)
+
+const maxBodySize = 64
+
+// Handler returns the HTTP handler responding to GET with the current log level and setting the log level to the
+// body of PUT and POST requests
+func Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+		case http.MethodPut, http.MethodPost:
+			body, err := io.ReadAll(io.LimitReader(r.Body, maxBodySize))
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			if err := set(r.Context(), string(body), "HTTP request"); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+		default:
+			w.Header().Set("Allow", "GET, PUT, POST")
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		_, _ = io.WriteString(w, logrus.GetLevel().String()+"\n")
+	})
+}
+
+// WatchFile sets the log level to the content of the file, if it exists, and again on each change of the file until
+// ctx is done
+func WatchFile(ctx context.Context, file string) error {
+	if err := load(ctx, file); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return errors.Wrap(err, "failed to create a file watcher")
+	}
+	// The directory is watched instead of the file, because mounted ConfigMaps are updated by swapping symlinks
+	if err := watcher.Add(filepath.Dir(file)); err != nil {
+		_ = watcher.Close()
+		return errors.Wrapf(err, "failed to watch %s", filepath.Dir(file))
+	}
+	go watch(ctx, watcher, file)
+
+	return nil
+}
+
+func watch(ctx context.Context, watcher *fsnotify.Watcher, file string) {
+	logger := log.FromContext(ctx).WithField("loglevel", "watch")
+	defer func() { _ = watcher.Close() }()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event, ok := <-watcher.Events:
+			if !ok {
+				return
+			}
+			if filepath.Clean(event.Name) != filepath.Clean(file) && filepath.Base(event.Name) != "..data" {
+				continue
+			}
+			if err := load(ctx, file); err != nil && !errors.Is(err, os.ErrNotExist) {
+				logger.Warnf("failed to reload the log level, keeping %s: %v", logrus.GetLevel(), err.Error())
+			}
+		case err, ok := <-watcher.Errors:
+			if !ok {
+				return
+			}
+			logger.Warnf("file watcher error: %v", err.Error())
+		}
+	}
+}
+
+func load(ctx context.Context, file string) error {
+	content, err := os.ReadFile(filepath.Clean(file))
+	if err != nil {
+		return errors.Wrapf(err, "failed to read the log level from %s", file)
+	}
+	return set(ctx, string(content), file)
+}
+
+func set(ctx context.Context, value, source string) error {
+	level, err := logrus.ParseLevel(strings.TrimSpace(value))
+	if err != nil {
+		return errors.Wrapf(err, "invalid log level %q", strings.TrimSpace(value))
+	}
+	if level != logrus.GetLevel() {
+		log.FromContext(ctx).WithField("loglevel", "set").Infof("Setting log level to %s from %s", level, source)
+		logrus.SetLevel(level)
+	}
+	return nil
+}
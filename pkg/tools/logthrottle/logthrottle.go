@@ -0,0 +1,144 @@
+// Copyright (c) 2026 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package logthrottle provides a logrus formatter collapsing repeated warnings and errors into periodic summaries
+package logthrottle
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// summaryField marks summary entries, which are never throttled
+	summaryField = "repeated"
+
+	// maxKeys bounds the number of tracked messages, entries with new messages are not throttled above it
+	maxKeys = 4096
+)
+
+type key struct {
+	level   logrus.Level
+	message string
+}
+
+type seen struct {
+	since      time.Time
+	suppressed int64
+}
+
+// Formatter formats entries with the wrapped formatter, dropping warnings and errors repeating a message already
+// logged in the current interval. Messages of the registry errors contain the NSE name and the error, so repeats are
+// collapsed per NSE and error. The number of dropped entries is logged once the interval is over.
+type Formatter struct {
+	logrus.Formatter
+	interval time.Duration
+
+	mu      sync.Mutex
+	entries map[key]*seen
+}
+
+// New returns the formatter wrapping formatter and throttling repeated messages for interval
+func New(formatter logrus.Formatter, interval time.Duration) *Formatter {
+	return &Formatter{
+		Formatter: formatter,
+		interval:  interval,
+		entries:   make(map[key]*seen),
+	}
+}
+
+// Format formats the entry, or returns no bytes if it repeats a message logged in the current interval
+func (f *Formatter) Format(entry *logrus.Entry) ([]byte, error) {
+	countEntry(entry.Level)
+	if entry.Level > logrus.WarnLevel {
+		return f.Formatter.Format(entry)
+	}
+	if _, ok := entry.Data[summaryField]; ok {
+		return f.Formatter.Format(entry)
+	}
+
+	k := key{level: entry.Level, message: entry.Message}
+	now := time.Now()
+
+	f.mu.Lock()
+	s, ok := f.entries[k]
+	switch {
+	case ok && now.Sub(s.since) < f.interval:
+		s.suppressed++
+		f.mu.Unlock()
+		countSuppressed(entry.Level)
+		return nil, nil
+	case !ok && len(f.entries) >= maxKeys:
+		f.mu.Unlock()
+		return f.Formatter.Format(entry)
+	}
+	f.entries[k] = &seen{since: now}
+	f.mu.Unlock()
+
+	// The previous interval of the message is over, but hasn't been summarized by Run yet
+	if ok && s.suppressed > 0 {
+		summary, err := f.Formatter.Format(summaryEntry(entry.Logger, k, s, now))
+		if err != nil {
+			return nil, err
+		}
+		serialized, err := f.Formatter.Format(entry)
+		return append(summary, serialized...), err
+	}
+	return f.Formatter.Format(entry)
+}
+
+// Run logs summaries of the messages throttled in the intervals which are over every interval. It blocks until ctx is
+// done.
+func (f *Formatter) Run(ctx context.Context, logger *logrus.Logger) {
+	ticker := time.NewTicker(f.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		now := time.Now()
+		var summaries []*logrus.Entry
+		f.mu.Lock()
+		for k, s := range f.entries {
+			if now.Sub(s.since) < f.interval {
+				continue
+			}
+			if s.suppressed > 0 {
+				summaries = append(summaries, summaryEntry(logger, k, s, now))
+			}
+			delete(f.entries, k)
+		}
+		f.mu.Unlock()
+
+		for _, summary := range summaries {
+			summary.Log(summary.Level, summary.Message)
+		}
+	}
+}
+
+func summaryEntry(logger *logrus.Logger, k key, s *seen, now time.Time) *logrus.Entry {
+	entry := logrus.NewEntry(logger).WithField(summaryField, s.suppressed).WithTime(now)
+	entry.Level = k.level
+	entry.Message = fmt.Sprintf("Seen %d more times in the last %v: %s", s.suppressed, now.Sub(s.since).Round(time.Second), k.message)
+	return entry
+}
@@ -0,0 +1,66 @@
+// Copyright (c) 2026 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logthrottle
+
+import (
+	"context"
+
+	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+const (
+	meterName             = "github.com/networkservicemesh/cmd-registry-k8s/pkg/tools/logthrottle"
+	entriesCounterName    = "registry_k8s_log_entries_total"
+	suppressedCounterName = "registry_k8s_log_entries_suppressed_total"
+)
+
+var (
+	entriesCounter    metric.Int64Counter
+	suppressedCounter metric.Int64Counter
+)
+
+func init() {
+	meter := otel.Meter(meterName)
+	var err error
+	if entriesCounter, err = meter.Int64Counter(
+		entriesCounterName,
+		metric.WithDescription("Number of log entries by level, including the throttled ones"),
+	); err != nil {
+		otel.Handle(err)
+	}
+	if suppressedCounter, err = meter.Int64Counter(
+		suppressedCounterName,
+		metric.WithDescription("Number of log entries dropped as repeats by level"),
+	); err != nil {
+		otel.Handle(err)
+	}
+}
+
+func countEntry(level logrus.Level) {
+	if entriesCounter != nil {
+		entriesCounter.Add(context.Background(), 1, metric.WithAttributes(attribute.String("level", level.String())))
+	}
+}
+
+func countSuppressed(level logrus.Level) {
+	if suppressedCounter != nil {
+		suppressedCounter.Add(context.Background(), 1, metric.WithAttributes(attribute.String("level", level.String())))
+	}
+}
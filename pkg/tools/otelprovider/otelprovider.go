@@ -0,0 +1,78 @@
+// Copyright (c) 2026 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package otelprovider provides the OpenTelemetry tracer and meter providers of the registry sharing a single resource
+package otelprovider
+
+import (
+	"context"
+	"io"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+
+	"github.com/networkservicemesh/sdk/pkg/tools/log"
+)
+
+type providers struct {
+	ctx            context.Context
+	tracerProvider *sdktrace.TracerProvider
+	meterProvider  *sdkmetric.MeterProvider
+}
+
+// Init sets the global tracer provider exporting spans with spanExporter and the global meter provider reading metrics
+// with metricReader, both describing the telemetry with res. Nil exporters and readers leave the global providers
+// unset. Unlike opentelemetry.Init of the sdk, the resource is built by the caller, so it can have attributes besides
+// the service name. Closing the returned closer flushes and shuts the providers down.
+func Init(ctx context.Context, spanExporter sdktrace.SpanExporter, metricReader sdkmetric.Reader, res *resource.Resource) io.Closer {
+	p := &providers{
+		ctx: ctx,
+	}
+	if spanExporter != nil {
+		p.tracerProvider = sdktrace.NewTracerProvider(
+			sdktrace.WithSampler(sdktrace.AlwaysSample()),
+			sdktrace.WithResource(res),
+			sdktrace.WithSpanProcessor(sdktrace.NewBatchSpanProcessor(spanExporter)),
+		)
+		otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}))
+		otel.SetTracerProvider(p.tracerProvider)
+	}
+	if metricReader != nil {
+		p.meterProvider = sdkmetric.NewMeterProvider(
+			sdkmetric.WithResource(res),
+			sdkmetric.WithReader(metricReader),
+		)
+		otel.SetMeterProvider(p.meterProvider)
+	}
+	return p
+}
+
+func (p *providers) Close() error {
+	if p.tracerProvider != nil {
+		if err := p.tracerProvider.Shutdown(p.ctx); err != nil {
+			log.FromContext(p.ctx).Errorf("failed to shutdown tracer provider: %v", err)
+		}
+	}
+	if p.meterProvider != nil {
+		if err := p.meterProvider.Shutdown(p.ctx); err != nil {
+			log.FromContext(p.ctx).Errorf("failed to shutdown meter provider: %v", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,207 @@
+// Copyright (c) 2026 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package otlpexport provides OTLP span exporters and metric readers over gRPC or HTTP, with optional TLS and custom
+// headers
+package otlpexport
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricgrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetrichttp"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"google.golang.org/grpc/credentials"
+)
+
+const (
+	// ProtocolGRPC exports with OTLP over gRPC
+	ProtocolGRPC = "grpc"
+	// ProtocolHTTP exports with OTLP over HTTP with protobuf payloads
+	ProtocolHTTP = "http"
+)
+
+// Config is the configuration of the OTLP exporters
+type Config struct {
+	// Endpoint is the host:port of the collector
+	Endpoint string
+	// Protocol is ProtocolGRPC or ProtocolHTTP
+	Protocol string
+	// Insecure disables TLS
+	Insecure bool
+	// CAFile is the PEM file with the CAs verifying the collector, empty uses the system CAs
+	CAFile string
+	// CertFile and KeyFile are the PEM files with the client certificate and key for mTLS, empty disables mTLS
+	CertFile string
+	KeyFile  string
+	// Headers are sent with every export, e.g. API keys of SaaS collectors
+	Headers map[string]string
+}
+
+// Validate checks that the protocol is supported and the client certificate and key are set together
+func (c *Config) Validate() error {
+	if c.Protocol != ProtocolGRPC && c.Protocol != ProtocolHTTP {
+		return errors.Errorf("unknown OTLP protocol %q, supported protocols: %s, %s", c.Protocol, ProtocolGRPC, ProtocolHTTP)
+	}
+	if (c.CertFile == "") != (c.KeyFile == "") {
+		return errors.New("OTLP client cert and key files must be set together")
+	}
+	return nil
+}
+
+// NewSpanExporter returns the span exporter sending spans to the collector
+func NewSpanExporter(ctx context.Context, c *Config) (sdktrace.SpanExporter, error) {
+	client, err := c.traceClient()
+	if err != nil {
+		return nil, err
+	}
+	exporter, err := otlptrace.New(ctx, client)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create the OTLP span exporter for %s", c.Endpoint)
+	}
+	return exporter, nil
+}
+
+// NewMetricReader returns the reader pushing metrics to the collector every interval
+func NewMetricReader(ctx context.Context, c *Config, interval time.Duration) (sdkmetric.Reader, error) {
+	exporter, err := c.metricExporter(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return sdkmetric.NewPeriodicReader(exporter, sdkmetric.WithInterval(interval)), nil
+}
+
+// CheckSpans sends an empty span export to the collector. Failed exports are retried like the ones of the span
+// exporter until ctx is done, then the last error is returned.
+func CheckSpans(ctx context.Context, c *Config) error {
+	client, err := c.traceClient()
+	if err != nil {
+		return err
+	}
+	if err = client.Start(ctx); err != nil {
+		return errors.Wrapf(err, "failed to start the OTLP trace client for %s", c.Endpoint)
+	}
+	defer func() { _ = client.Stop(context.Background()) }()
+	if err = client.UploadTraces(ctx, nil); err != nil {
+		return errors.Wrapf(err, "failed to export spans to %s", c.Endpoint)
+	}
+	return nil
+}
+
+// CheckMetrics sends an empty metric export to the collector. Failed exports are retried like the ones of the metric
+// reader until ctx is done, then the last error is returned.
+func CheckMetrics(ctx context.Context, c *Config) error {
+	exporter, err := c.metricExporter(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = exporter.Shutdown(context.Background()) }()
+	if err = exporter.Export(ctx, new(metricdata.ResourceMetrics)); err != nil {
+		return errors.Wrapf(err, "failed to export metrics to %s", c.Endpoint)
+	}
+	return nil
+}
+
+func (c *Config) traceClient() (otlptrace.Client, error) {
+	tlsConfig, err := c.tlsConfig()
+	if err != nil {
+		return nil, err
+	}
+	if c.Protocol == ProtocolHTTP {
+		options := []otlptracehttp.Option{otlptracehttp.WithEndpoint(c.Endpoint), otlptracehttp.WithHeaders(c.Headers)}
+		if tlsConfig == nil {
+			options = append(options, otlptracehttp.WithInsecure())
+		} else {
+			options = append(options, otlptracehttp.WithTLSClientConfig(tlsConfig))
+		}
+		return otlptracehttp.NewClient(options...), nil
+	}
+	options := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(c.Endpoint), otlptracegrpc.WithHeaders(c.Headers)}
+	if tlsConfig == nil {
+		options = append(options, otlptracegrpc.WithInsecure())
+	} else {
+		options = append(options, otlptracegrpc.WithTLSCredentials(credentials.NewTLS(tlsConfig)))
+	}
+	return otlptracegrpc.NewClient(options...), nil
+}
+
+func (c *Config) metricExporter(ctx context.Context) (sdkmetric.Exporter, error) {
+	tlsConfig, err := c.tlsConfig()
+	if err != nil {
+		return nil, err
+	}
+	var exporter sdkmetric.Exporter
+	if c.Protocol == ProtocolHTTP {
+		options := []otlpmetrichttp.Option{otlpmetrichttp.WithEndpoint(c.Endpoint), otlpmetrichttp.WithHeaders(c.Headers)}
+		if tlsConfig == nil {
+			options = append(options, otlpmetrichttp.WithInsecure())
+		} else {
+			options = append(options, otlpmetrichttp.WithTLSClientConfig(tlsConfig))
+		}
+		exporter, err = otlpmetrichttp.New(ctx, options...)
+	} else {
+		options := []otlpmetricgrpc.Option{otlpmetricgrpc.WithEndpoint(c.Endpoint), otlpmetricgrpc.WithHeaders(c.Headers)}
+		if tlsConfig == nil {
+			options = append(options, otlpmetricgrpc.WithInsecure())
+		} else {
+			options = append(options, otlpmetricgrpc.WithTLSCredentials(credentials.NewTLS(tlsConfig)))
+		}
+		exporter, err = otlpmetricgrpc.New(ctx, options...)
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create the OTLP metric exporter for %s", c.Endpoint)
+	}
+	return exporter, nil
+}
+
+// tlsConfig returns the TLS config of the collector connection, or nil if it is insecure
+func (c *Config) tlsConfig() (*tls.Config, error) {
+	if c.Insecure {
+		return nil, nil
+	}
+	config := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+	}
+	if c.CAFile != "" {
+		pem, err := os.ReadFile(filepath.Clean(c.CAFile))
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to read the OTLP CA file %s", c.CAFile)
+		}
+		config.RootCAs = x509.NewCertPool()
+		if !config.RootCAs.AppendCertsFromPEM(pem) {
+			return nil, errors.Errorf("no certificates found in the OTLP CA file %s", c.CAFile)
+		}
+	}
+	if c.CertFile != "" {
+		cert, err := tls.LoadX509KeyPair(c.CertFile, c.KeyFile)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to load the OTLP client cert %s and key %s", c.CertFile, c.KeyFile)
+		}
+		config.Certificates = []tls.Certificate{cert}
+	}
+	return config, nil
+}
@@ -0,0 +1,84 @@
+// Copyright (c) 2026 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package otlpexport
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+// closedPortEndpoint returns the endpoint of a local port nothing listens on
+func closedPortEndpoint(t *testing.T) string {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	endpoint := l.Addr().String()
+	if err := l.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return endpoint
+}
+
+func TestCheck_ClosedPort(t *testing.T) {
+	for _, protocol := range []string{ProtocolGRPC, ProtocolHTTP} {
+		t.Run(protocol, func(t *testing.T) {
+			c := &Config{Endpoint: closedPortEndpoint(t), Protocol: protocol, Insecure: true}
+			for name, check := range map[string]func(context.Context, *Config) error{
+				"spans":   CheckSpans,
+				"metrics": CheckMetrics,
+			} {
+				ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+				err := check(ctx, c)
+				cancel()
+				if err == nil {
+					t.Fatalf("%s check succeeded against a closed port", name)
+				}
+				if !strings.Contains(err.Error(), c.Endpoint) {
+					t.Fatalf("%s check error doesn't name the endpoint: %v", name, err)
+				}
+			}
+		})
+	}
+}
+
+func TestCheck_HTTP(t *testing.T) {
+	var paths []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		w.Header().Set("Content-Type", "application/x-protobuf")
+	}))
+	defer server.Close()
+
+	c := &Config{Endpoint: strings.TrimPrefix(server.URL, "http://"), Protocol: ProtocolHTTP, Insecure: true}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if err := CheckSpans(ctx, c); err != nil {
+		t.Fatal(err)
+	}
+	if err := CheckMetrics(ctx, c); err != nil {
+		t.Fatal(err)
+	}
+	if strings.Join(paths, ",") != "/v1/traces,/v1/metrics" {
+		t.Fatalf("exports sent to %v, want /v1/traces and /v1/metrics", paths)
+	}
+}
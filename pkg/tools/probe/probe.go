@@ -0,0 +1,119 @@
+// Copyright (c) 2026 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package probe provides an HTTP listener for Kubernetes liveness, readiness and startup probes
+package probe
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	readHeaderTimeout = 5 * time.Second
+	shutdownTimeout   = time.Second
+)
+
+// Status reports the state of the registry to the probes
+type Status interface {
+	// Serving returns true if the registry is ready to serve requests
+	Serving() bool
+	// Started returns true if the registry has completed its startup
+	Started() bool
+}
+
+// ListenAndServe serves the probes on addr until ctx is done:
+//   - /healthz succeeds while the process is able to serve HTTP and the liveness check, if any, passes
+//   - /readyz succeeds while status is serving
+//   - /startupz succeeds once status has started
+//
+// The returned channel receives the serve error and is closed when the listener stops.
+func ListenAndServe(ctx context.Context, addr string, status Status, opts ...Option) <-chan error {
+	o := new(options)
+	for _, opt := range opts {
+		opt(o)
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/healthz", livenessHandler(o))
+	mux.HandleFunc("/readyz", handler(status.Serving))
+	mux.HandleFunc("/startupz", handler(status.Started))
+	server := &http.Server{
+		Addr:              addr,
+		Handler:           mux,
+		ReadHeaderTimeout: readHeaderTimeout,
+		ReadTimeout:       o.readTimeout,
+		WriteTimeout:      o.writeTimeout,
+		IdleTimeout:       o.idleTimeout,
+	}
+
+	errCh := make(chan error, 1)
+	go func() {
+		defer close(errCh)
+		if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			errCh <- errors.Wrapf(err, "failed to serve probes on %s", addr)
+		}
+	}()
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer cancel()
+		_ = server.Shutdown(shutdownCtx)
+	}()
+	return errCh
+}
+
+func handler(ok func() bool) http.HandlerFunc {
+	return func(w http.ResponseWriter, _ *http.Request) {
+		if !ok() {
+			http.Error(w, "not ok", http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte("ok"))
+	}
+}
+
+func livenessHandler(o *options) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if o.liveness != nil {
+			if err := checkLiveness(r.Context(), o); err != nil {
+				http.Error(w, err.Error(), http.StatusServiceUnavailable)
+				return
+			}
+		}
+		_, _ = w.Write([]byte("ok"))
+	}
+}
+
+// checkLiveness runs the liveness check with the timeout. A deadlocked check may never return, so it is abandoned when
+// the timeout expires.
+func checkLiveness(ctx context.Context, o *options) error {
+	ctx, cancel := context.WithTimeout(ctx, o.livenessTimeout)
+	defer cancel()
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- o.liveness(ctx)
+	}()
+	select {
+	case err := <-errCh:
+		return errors.Wrap(err, "liveness check failed")
+	case <-ctx.Done():
+		return errors.Wrapf(ctx.Err(), "liveness check hasn't completed in %v", o.livenessTimeout)
+	}
+}
@@ -0,0 +1,120 @@
+// Copyright (c) 2026 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package probe
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+func TestLivenessHandler(t *testing.T) {
+	hang := make(chan struct{})
+	defer close(hang)
+
+	for _, tc := range []struct {
+		name  string
+		check func(ctx context.Context) error
+		code  int
+	}{
+		{name: "no check", code: http.StatusOK},
+		{name: "passing check", check: func(context.Context) error { return nil }, code: http.StatusOK},
+		{name: "failing check", check: func(context.Context) error { return errors.New("failed") }, code: http.StatusServiceUnavailable},
+		{name: "deadlocked check", check: func(context.Context) error { <-hang; return nil }, code: http.StatusServiceUnavailable},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			o := new(options)
+			if tc.check != nil {
+				WithLivenessCheck(tc.check, 100*time.Millisecond)(o)
+			}
+			recorder := httptest.NewRecorder()
+			start := time.Now()
+			livenessHandler(o)(recorder, httptest.NewRequest(http.MethodGet, "/healthz", http.NoBody))
+			if recorder.Code != tc.code {
+				t.Fatalf("/healthz returned %d, want %d: %s", recorder.Code, tc.code, recorder.Body.String())
+			}
+			if elapsed := time.Since(start); elapsed > time.Second {
+				t.Fatalf("/healthz took %v", elapsed)
+			}
+		})
+	}
+}
+
+type serving struct{}
+
+func (serving) Serving() bool { return true }
+func (serving) Started() bool { return true }
+
+func TestListenAndServe_IdleTimeout(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr := l.Addr().String()
+	_ = l.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	errCh := ListenAndServe(ctx, addr, serving{}, WithTimeouts(time.Second, time.Second, 100*time.Millisecond))
+
+	var conn net.Conn
+	for deadline := time.Now().Add(time.Second); ; {
+		if conn, err = net.Dial("tcp", addr); err == nil {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("probe listener is not serving: %v", err)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	defer func() { _ = conn.Close() }()
+
+	if _, err = conn.Write([]byte("GET /healthz HTTP/1.1\r\nHost: probe\r\n\r\n")); err != nil {
+		t.Fatal(err)
+	}
+	_ = conn.SetReadDeadline(time.Now().Add(2 * time.Second))
+	reader := bufio.NewReader(conn)
+	resp, err := http.ReadResponse(reader, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("/healthz returned %d", resp.StatusCode)
+	}
+
+	// The kept alive connection is closed once it has been idle for the idle timeout
+	start := time.Now()
+	if _, err = io.ReadAll(reader); err != nil {
+		t.Fatalf("idle connection is not closed: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Fatalf("idle connection is closed after %v", elapsed)
+	}
+
+	cancel()
+	if err := <-errCh; err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,119 @@
+// Copyright (c) 2026 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package rbaccheck provides a check of the RBAC permissions the registry needs for NSEs and NSs
+package rbaccheck
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	// Group is the API group of NSEs and NSs
+	Group = "networkservicemesh.io"
+	// NetworkServiceEndpoints is the resource of NSEs
+	NetworkServiceEndpoints = "networkserviceendpoints"
+	// NetworkServices is the resource of NSs
+	NetworkServices = "networkservices"
+)
+
+// namespacedVerbs are used in the registry namespace, clusterVerbs in all namespaces by Find
+var (
+	namespacedVerbs = []string{"get", "create", "update", "patch", "delete"}
+	clusterVerbs    = []string{"list", "watch"}
+)
+
+// Checker checks the permissions of the registry with SelfSubjectAccessReviews. Resources which have passed the check
+// once are not checked again.
+type Checker struct {
+	client    kubernetes.Interface
+	namespace string
+
+	mu     sync.Mutex
+	passed map[string]bool
+}
+
+// New returns the checker of the permissions in the namespace
+func New(client kubernetes.Interface, namespace string) *Checker {
+	return &Checker{
+		client:    client,
+		namespace: namespace,
+		passed:    make(map[string]bool),
+	}
+}
+
+// Check returns an error listing all verbs the registry is not allowed to use on the resource
+func (c *Checker) Check(ctx context.Context, resource string) error {
+	c.mu.Lock()
+	passed := c.passed[resource]
+	c.mu.Unlock()
+	if passed {
+		return nil
+	}
+
+	var missing []string
+	for _, verb := range namespacedVerbs {
+		allowed, err := c.review(ctx, resource, verb, c.namespace)
+		if err != nil {
+			return err
+		}
+		if !allowed {
+			missing = append(missing, fmt.Sprintf("%s in namespace %s", verb, c.namespace))
+		}
+	}
+	for _, verb := range clusterVerbs {
+		allowed, err := c.review(ctx, resource, verb, metav1.NamespaceAll)
+		if err != nil {
+			return err
+		}
+		if !allowed {
+			missing = append(missing, fmt.Sprintf("%s in all namespaces", verb))
+		}
+	}
+	if len(missing) > 0 {
+		return errors.Errorf("missing RBAC permissions for %s.%s: %s", resource, Group, strings.Join(missing, ", "))
+	}
+
+	c.mu.Lock()
+	c.passed[resource] = true
+	c.mu.Unlock()
+	return nil
+}
+
+func (c *Checker) review(ctx context.Context, resource, verb, namespace string) (bool, error) {
+	review, err := c.client.AuthorizationV1().SelfSubjectAccessReviews().Create(ctx, &authorizationv1.SelfSubjectAccessReview{
+		Spec: authorizationv1.SelfSubjectAccessReviewSpec{
+			ResourceAttributes: &authorizationv1.ResourceAttributes{
+				Namespace: namespace,
+				Verb:      verb,
+				Group:     Group,
+				Resource:  resource,
+			},
+		},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to review the permission to %s %s", verb, resource)
+	}
+	return review.Status.Allowed, nil
+}
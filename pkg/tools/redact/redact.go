@@ -0,0 +1,88 @@
+// Copyright (c) 2026 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package redact provides formatting of configuration structs that hides the values of sensitive fields
+package redact
+
+import (
+	"fmt"
+	"net/url"
+	"reflect"
+	"strings"
+)
+
+const (
+	secretTag   = "secret"
+	placeholder = "***"
+)
+
+var urlType = reflect.TypeOf(url.URL{})
+
+// Sprint formats v like fmt.Sprintf("%#v", v), except that values of struct fields tagged with `secret:"true"`
+// are replaced with "***" and URLs are formatted with their passwords replaced by "xxxxx". Nested and embedded
+// structs are formatted recursively.
+func Sprint(v interface{}) string {
+	var sb strings.Builder
+	write(&sb, reflect.ValueOf(v))
+	return sb.String()
+}
+
+func write(sb *strings.Builder, v reflect.Value) {
+	switch {
+	case !v.IsValid():
+		sb.WriteString("<nil>")
+	case v.Type() == urlType && v.CanInterface():
+		u := v.Interface().(url.URL)
+		_, _ = fmt.Fprintf(sb, "%q", u.Redacted())
+	case v.Type() == reflect.PointerTo(urlType) && !v.IsNil():
+		write(sb, v.Elem())
+	case v.Kind() == reflect.Slice && v.Type().Elem() == urlType:
+		sb.WriteString("[")
+		for i := 0; i < v.Len(); i++ {
+			if i > 0 {
+				sb.WriteString(", ")
+			}
+			write(sb, v.Index(i))
+		}
+		sb.WriteString("]")
+	case v.Kind() == reflect.Ptr && !v.IsNil() && v.Elem().Kind() == reflect.Struct:
+		sb.WriteString("&")
+		write(sb, v.Elem())
+	case v.Kind() == reflect.Struct:
+		t := v.Type()
+		sb.WriteString(t.String())
+		sb.WriteString("{")
+		for i := 0; i < t.NumField(); i++ {
+			if i > 0 {
+				sb.WriteString(", ")
+			}
+			field := t.Field(i)
+			sb.WriteString(field.Name)
+			sb.WriteString(":")
+			switch {
+			case field.Tag.Get(secretTag) == "true":
+				sb.WriteString(placeholder)
+			case !field.IsExported():
+				_, _ = fmt.Fprintf(sb, "%#v", v.Field(i))
+			default:
+				write(sb, v.Field(i))
+			}
+		}
+		sb.WriteString("}")
+	default:
+		_, _ = fmt.Fprintf(sb, "%#v", v.Interface())
+	}
+}
@@ -0,0 +1,84 @@
+// Copyright (c) 2026 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package retrypolicy provides an exponential backoff policy for retries of k8s API calls
+package retrypolicy
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/networkservicemesh/sdk/pkg/tools/clock"
+)
+
+// Policy defines the delays between retries. The first retry is made after InitialInterval, and each next delay is
+// Multiplier times longer up to MaxInterval. No retry is started after MaxElapsedTime since the first call.
+type Policy struct {
+	InitialInterval time.Duration
+	Multiplier      float64
+	MaxInterval     time.Duration
+	MaxElapsedTime  time.Duration
+}
+
+// Default is the policy used if none is set
+var Default = Policy{
+	InitialInterval: 10 * time.Millisecond,
+	Multiplier:      2,
+	MaxInterval:     time.Second,
+	MaxElapsedTime:  5 * time.Second,
+}
+
+// Validate checks that the intervals are positive and the delays don't shrink
+func (p *Policy) Validate() error {
+	if p.InitialInterval <= 0 || p.MaxInterval < p.InitialInterval {
+		return errors.Errorf("retry intervals must be positive and the max interval must not be less than the initial one: %v, %v", p.InitialInterval, p.MaxInterval)
+	}
+	if p.Multiplier < 1 {
+		return errors.Errorf("retry multiplier must not be less than 1: %v", p.Multiplier)
+	}
+	if p.MaxElapsedTime < 0 {
+		return errors.Errorf("retry max elapsed time must not be negative: %v", p.MaxElapsedTime)
+	}
+	return nil
+}
+
+// Retry calls fn until it succeeds or fails with an error retriable returns false for. It gives up with the last error
+// of fn once the next retry would start after MaxElapsedTime or ctx is done.
+func (p *Policy) Retry(ctx context.Context, retriable func(err error) bool, fn func() error) error {
+	timeClock := clock.FromContext(ctx)
+	start := timeClock.Now()
+	interval := p.InitialInterval
+	for {
+		err := fn()
+		if err == nil || !retriable(err) {
+			return err
+		}
+		if timeClock.Since(start)+interval > p.MaxElapsedTime {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return err
+		case <-timeClock.After(interval):
+		}
+		interval = time.Duration(float64(interval) * p.Multiplier)
+		if interval > p.MaxInterval {
+			interval = p.MaxInterval
+		}
+	}
+}
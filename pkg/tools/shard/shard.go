@@ -0,0 +1,189 @@
+// Copyright (c) 2026 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package shard provides sharding of NSE expiration handling across registry replicas. Each replica keeps its own
+// membership Lease alive, and every NSE is owned by the live member with the highest rendezvous hash of its name, so
+// only a small part of NSEs changes the owner when replicas join or leave.
+package shard
+
+import (
+	"context"
+	"hash/fnv"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkg/errors"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	coordinationclientv1 "k8s.io/client-go/kubernetes/typed/coordination/v1"
+
+	"github.com/networkservicemesh/sdk/pkg/tools/clock"
+	"github.com/networkservicemesh/sdk/pkg/tools/log"
+)
+
+// GroupLabel is the label of the membership Leases with the name of the shard group as a value
+const GroupLabel = "networkservicemesh.io/registry-shard-group"
+
+// Membership is a member of the shard group
+type Membership struct {
+	leases        coordinationclientv1.LeaseInterface
+	group         string
+	identity      string
+	leaseDuration time.Duration
+	members       atomic.Pointer[[]string]
+}
+
+// NewMembership returns the member of the group with the identity. Memberships are kept in Leases of the namespace
+// renewed within leaseDuration.
+func NewMembership(client kubernetes.Interface, namespace, group, identity string, leaseDuration time.Duration) *Membership {
+	return &Membership{
+		leases:        client.CoordinationV1().Leases(namespace),
+		group:         group,
+		identity:      identity,
+		leaseDuration: leaseDuration,
+	}
+}
+
+// Run keeps the membership Lease alive and refreshes the list of live members every third of the lease duration. The
+// Lease is deleted when ctx is done. It blocks until ctx is done.
+func (m *Membership) Run(ctx context.Context) {
+	logger := log.FromContext(ctx).WithField("shard", m.group)
+	ticker := clock.FromContext(ctx).Ticker(m.leaseDuration / 3)
+	defer ticker.Stop()
+	defer func() {
+		deleteCtx, cancel := context.WithTimeout(context.Background(), m.leaseDuration/3)
+		defer cancel()
+		if err := m.leases.Delete(deleteCtx, m.leaseName(), metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+			logger.Warnf("failed to delete the membership lease: %v", err.Error())
+		}
+	}()
+
+	for {
+		if err := m.renew(ctx); err != nil {
+			logger.Warnf("failed to renew the membership lease: %v", err.Error())
+		}
+		if err := m.refresh(ctx); err != nil {
+			logger.Warnf("failed to refresh the shard members: %v", err.Error())
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C():
+		}
+	}
+}
+
+// Owns returns true if the member is responsible for the expiration of the NSE with the name. It returns false until
+// the members are listed for the first time.
+func (m *Membership) Owns(name string) bool {
+	members := m.members.Load()
+	if members == nil {
+		return false
+	}
+	var owner string
+	var maxScore uint64
+	for _, member := range *members {
+		if score := rendezvousScore(member, name); owner == "" || score > maxScore {
+			owner, maxScore = member, score
+		}
+	}
+	return owner == m.identity
+}
+
+// Alive returns true if the member with the identity has a live membership Lease. It returns true until the members
+// are listed for the first time.
+func (m *Membership) Alive(identity string) bool {
+	members := m.members.Load()
+	if members == nil {
+		return true
+	}
+	for _, member := range *members {
+		if member == identity {
+			return true
+		}
+	}
+	return false
+}
+
+func (m *Membership) leaseName() string {
+	return m.group + "-" + m.identity
+}
+
+func (m *Membership) renew(ctx context.Context) error {
+	now := metav1.NewMicroTime(clock.FromContext(ctx).Now())
+	leaseDurationSeconds := int32(m.leaseDuration / time.Second)
+
+	lease, err := m.leases.Get(ctx, m.leaseName(), metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		_, err = m.leases.Create(ctx, &coordinationv1.Lease{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   m.leaseName(),
+				Labels: map[string]string{GroupLabel: m.group},
+			},
+			Spec: coordinationv1.LeaseSpec{
+				HolderIdentity:       &m.identity,
+				LeaseDurationSeconds: &leaseDurationSeconds,
+				AcquireTime:          &now,
+				RenewTime:            &now,
+			},
+		}, metav1.CreateOptions{})
+		return errors.Wrapf(err, "failed to create the lease %s", m.leaseName())
+	}
+	if err != nil {
+		return errors.Wrapf(err, "failed to get the lease %s", m.leaseName())
+	}
+	lease.Spec.HolderIdentity = &m.identity
+	lease.Spec.LeaseDurationSeconds = &leaseDurationSeconds
+	lease.Spec.RenewTime = &now
+	_, err = m.leases.Update(ctx, lease, metav1.UpdateOptions{})
+	return errors.Wrapf(err, "failed to update the lease %s", m.leaseName())
+}
+
+func (m *Membership) refresh(ctx context.Context) error {
+	list, err := m.leases.List(ctx, metav1.ListOptions{
+		LabelSelector: GroupLabel + "=" + m.group,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to list the membership leases")
+	}
+
+	now := clock.FromContext(ctx).Now()
+	var members []string
+	for i := range list.Items {
+		spec := &list.Items[i].Spec
+		if spec.HolderIdentity == nil || spec.RenewTime == nil || spec.LeaseDurationSeconds == nil {
+			continue
+		}
+		if spec.RenewTime.Add(time.Duration(*spec.LeaseDurationSeconds) * time.Second).After(now) {
+			members = append(members, *spec.HolderIdentity)
+		}
+	}
+
+	if old := m.members.Swap(&members); old == nil || len(*old) != len(members) {
+		log.FromContext(ctx).WithField("shard", m.group).Infof("shard members: %v", members)
+	}
+	return nil
+}
+
+func rendezvousScore(member, name string) uint64 {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(member))
+	_, _ = h.Write([]byte{0})
+	_, _ = h.Write([]byte(name))
+	return h.Sum64()
+}
@@ -0,0 +1,73 @@
+// Copyright (c) 2026 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package statslog provides periodic logging of aggregate registry statistics
+package statslog
+
+import (
+	"context"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/networkservicemesh/sdk/pkg/tools/clock"
+	"github.com/networkservicemesh/sdk/pkg/tools/log"
+
+	"github.com/networkservicemesh/sdk-k8s/pkg/tools/k8s/client/clientset/versioned"
+
+	"github.com/networkservicemesh/cmd-registry-k8s/pkg/registry/common/stats"
+)
+
+// Run logs a summary of the NSEs in the namespace and of the registry call rates every interval. It blocks until ctx
+// is done.
+func Run(ctx context.Context, interval time.Duration, counters *stats.Counters, client versioned.Interface, namespace string) {
+	logger := log.FromContext(ctx).WithField("statslog", "Run")
+	timeClock := clock.FromContext(ctx)
+	ticker := timeClock.Ticker(interval)
+	defer ticker.Stop()
+
+	prev := counters.Snapshot()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C():
+		}
+
+		curr := counters.Snapshot()
+		seconds := interval.Seconds()
+		total, expired := -1, -1
+		if list, err := client.NetworkservicemeshV1().NetworkServiceEndpoints(namespace).List(ctx, metav1.ListOptions{}); err == nil {
+			total, expired = len(list.Items), 0
+			now := timeClock.Now()
+			for i := range list.Items {
+				if exp := list.Items[i].Spec.ExpirationTime; exp != nil && exp.AsTime().Before(now) {
+					expired++
+				}
+			}
+		} else {
+			logger.Warnf("failed to list NSEs: %v", err.Error())
+		}
+		logger.Infof("NSEs: total %d, expired %d; registrations/sec: %.2f, unregistrations/sec: %.2f, finds/sec: %.2f, errors/sec: %.2f",
+			total, expired,
+			float64(curr.Registrations-prev.Registrations)/seconds,
+			float64(curr.Unregistrations-prev.Unregistrations)/seconds,
+			float64(curr.Finds-prev.Finds)/seconds,
+			float64(curr.Errors-prev.Errors)/seconds,
+		)
+		prev = curr
+	}
+}
@@ -0,0 +1,75 @@
+// Copyright (c) 2026 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package tracesampling provides gRPC server interceptors enabling chain traces only for a fraction of requests
+package tracesampling
+
+import (
+	"context"
+	"math/rand"
+	"strconv"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/networkservicemesh/sdk/pkg/tools/grpcutils"
+)
+
+// traceKey is the metadata key grpcutils.TraceFromContext reads the trace state from
+const traceKey = "GrpcTracing"
+
+// UnaryServerInterceptor returns the interceptor tracing the chain of a ratio of requests. Requests whose client has
+// already decided on tracing keep the decision.
+func UnaryServerInterceptor(ratio float64) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		return handler(sample(ctx, ratio), req)
+	}
+}
+
+// StreamServerInterceptor returns the interceptor tracing the chain of a ratio of streams. Streams whose client has
+// already decided on tracing keep the decision.
+func StreamServerInterceptor(ratio float64) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &serverStream{
+			ServerStream: ss,
+			ctx:          sample(ss.Context(), ratio),
+		})
+	}
+}
+
+func sample(ctx context.Context, ratio float64) context.Context {
+	if grpcutils.TraceFromContext(ctx) != grpcutils.TraceUndefined {
+		return ctx
+	}
+	state := grpcutils.TraceOff
+	// Sampling doesn't need a cryptographically secure random number
+	if rand.Float64() < ratio {
+		state = grpcutils.TraceOn
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	md = md.Copy()
+	md.Set(traceKey, strconv.Itoa(int(state)))
+	return metadata.NewIncomingContext(ctx, md)
+}
+
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}